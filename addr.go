@@ -0,0 +1,261 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net/netip"
+)
+
+// Addr implements a nullable netip.Addr, meant to be used with IP address
+// columns such as PostgreSQL inet. Only single-host inet values are
+// accepted, since a netmask cannot be stored in a netip.Addr: inet columns
+// which hold netmasks, such as 192.168.1.5/24, must use Prefix instead.
+type Addr struct {
+	// Addr holds the underlying netip.Addr value.
+	Addr netip.Addr
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// AddrFrom creates a valid Addr from v.
+func AddrFrom(v netip.Addr) Addr {
+	return AddrFromPtr(&v)
+}
+
+// AddrFromPtr creates an Addr from pointer p. If p is nil,
+// the returned Addr is invalid.
+func AddrFromPtr(p *netip.Addr) Addr {
+	if p != nil {
+		return Addr{
+			Addr:  *p,
+			Valid: true,
+		}
+	}
+	return Addr{}
+}
+
+// AddrFromZero creates an Addr from v. If v is the zero netip.Addr,
+// the returned Addr is invalid.
+func AddrFromZero(v netip.Addr) Addr {
+	return Addr{
+		Addr:  v,
+		Valid: v.IsValid(),
+	}
+}
+
+// Ptr returns a pointer to the underlying value of a if a is valid,
+// otherwise returns nil.
+func (a Addr) Ptr() *netip.Addr {
+	if a.Valid {
+		return &a.Addr
+	}
+	return nil
+}
+
+// Zero returns the underlying value of a if a is valid, otherwise returns
+// the zero netip.Addr.
+func (a Addr) Zero() netip.Addr {
+	if a.Valid {
+		return a.Addr
+	}
+	return netip.Addr{}
+}
+
+// From sets the underlying value of a to v. a becomes valid.
+func (a *Addr) From(v netip.Addr) {
+	a.Addr = v
+	a.Valid = true
+}
+
+// FromPtr invalidates a if p is nil, otherwise it sets the underlying value
+// of a to the value pointed to by p, and a becomes valid.
+func (a *Addr) FromPtr(p *netip.Addr) {
+	a.Valid = p != nil
+	if p != nil {
+		a.Addr = *p
+	}
+}
+
+// FromZero invalidates a if v is the zero netip.Addr, otherwise it sets the
+// underlying value of a to v, and a becomes valid.
+func (a *Addr) FromZero(v netip.Addr) {
+	a.Addr = v
+	a.Valid = v.IsValid()
+}
+
+// IsValid returns true if a is valid.
+func (a Addr) IsValid() bool {
+	return a.Valid
+}
+
+// Invalidate makes a invalid.
+func (a *Addr) Invalidate() {
+	a.Valid = false
+}
+
+// Interface returns the underlying value of a as netip.Addr if a is valid,
+// otherwise nil.
+func (a Addr) Interface() interface{} {
+	if a.Valid {
+		return a.Addr
+	}
+	return nil
+}
+
+// SetInterface invalidates a if v is nil, otherwise if v's type is
+// netip.Addr, it sets the underlying value of a to v, and a becomes valid.
+// If v's type is any other type, a becomes invalid, and a TypeError is
+// returned.
+func (a *Addr) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case netip.Addr:
+		a.From(value)
+		return nil
+	case nil:
+		a.Valid = false
+		return nil
+	default:
+		a.Valid = false
+		return makeTypeError("set", value, "netip.Addr", "nil")
+	}
+}
+
+// String returns a string representation of a. If a is valid,
+// it formats the underlying value of a like netip.Addr.String does,
+// otherwise it returns InvalidNullableString.
+func (a Addr) String() string {
+	if a.Valid {
+		return a.Addr.String()
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals a to a byte string representation. If a is valid,
+// it formats the underlying value of a like netip.Addr.String does,
+// otherwise it returns nil. err is always nil.
+func (a Addr) MarshalText() (data []byte, err error) {
+	if a.Valid {
+		return []byte(a.Addr.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of a to a JSON string if a is
+// valid, otherwise it returns the JSON null value. err is always nil.
+func (a Addr) MarshalJSON() (data []byte, err error) {
+	if a.Valid {
+		return []byte(`"` + a.Addr.String() + `"`), nil
+	}
+	return jNull, nil
+}
+
+// Value returns the string representation of the underlying value of a if a
+// is valid, otherwise nil. err is always nil.
+func (a Addr) Value() (v driver.Value, err error) {
+	if a.Valid {
+		return a.Addr.String(), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates a if str is the empty string, otherwise it parses str into
+// the underlying value of a, and a becomes valid. str is an IPv4 or IPv6
+// address, as accepted by netip.ParseAddr. A single-host prefix, such as
+// 10.0.0.1/32, is accepted as well, since PostgreSQL may format addresses
+// this way. If str cannot be parsed, a becomes invalid and a ParseError is
+// returned.
+func (a *Addr) Set(str string) error {
+	if str == "" {
+		a.Valid = false
+		return nil
+	}
+
+	a.Addr, a.Valid = parseAddr(str)
+	if !a.Valid {
+		return makeParseError("parse", str, *a)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to a.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (a *Addr) UnmarshalText(text []byte) error {
+	if a.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *a)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to a.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, a becomes invalid. If the encoded JSON data
+// represent a JSON string which Set accepts, a becomes valid, and the
+// underlying value of a is set accordingly, otherwise a ParseError is
+// returned. Other JSON types produce a TypeError. Malformed JSON produces an
+// UnmarshalError.
+func (a *Addr) UnmarshalJSON(data []byte) error {
+	var obj interface{}
+	if json.Unmarshal(data, &obj) != nil {
+		a.Valid = false
+		return makeUnmarshalError("json", data, *a)
+	}
+	switch value := obj.(type) {
+	case string:
+		if a.Set(value) != nil || value == "" {
+			a.Valid = false
+			return makeParseError("parse", value, *a)
+		}
+		return nil
+	case nil:
+		a.Valid = false
+		return nil
+	default:
+		a.Valid = false
+		return makeTypeError("json", value, "string", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is parsed like Set does, except that the empty string produces
+// a ParseError. If obj is nil, a becomes invalid. If obj's type is any other
+// type, a becomes invalid, and a TypeError is returned.
+func (a *Addr) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case string:
+		return a.scanString(value)
+	case []byte:
+		return a.scanString(string(value))
+	case nil:
+		a.Valid = false
+		return nil
+	default:
+		a.Valid = false
+		return makeTypeError("sql", value, "string", "[]byte", "nil")
+	}
+}
+
+// scanString parses an address returned by a database driver as a string.
+// Unlike Set, the empty string is not accepted.
+func (a *Addr) scanString(str string) error {
+	a.Addr, a.Valid = parseAddr(str)
+	if !a.Valid {
+		return makeParseError("sql", str, *a)
+	}
+	return nil
+}
+
+// parseAddr parses str as an IP address, or as a prefix which holds a single
+// address.
+func parseAddr(str string) (netip.Addr, bool) {
+	if v, err := netip.ParseAddr(str); err == nil {
+		return v, true
+	}
+	p, err := netip.ParsePrefix(str)
+	if err != nil || !p.IsSingleIP() {
+		return netip.Addr{}, false
+	}
+	return p.Addr(), true
+}
@@ -0,0 +1,296 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"math/big"
+)
+
+// defaultBigFloatPrec is the precision, in bits of mantissa, of the
+// big.Float values parsed by a BigFloat whose Prec is 0.
+const defaultBigFloatPrec = 256
+
+// BigFloat implements a nullable big.Float, for floating point numbers which
+// need more precision than a float64.
+type BigFloat struct {
+	// Float holds the underlying big.Float value. A nil Float is equivalent
+	// to zero.
+	Float *big.Float
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+
+	// Prec holds the precision, in bits of mantissa, of the values parsed by
+	// the Set, UnmarshalText, UnmarshalJSON and Scan methods. If it is 0,
+	// 256 bits are used. It is preserved by all the methods which modify f.
+	Prec uint
+}
+
+// BigFloatFrom creates a BigFloat from a copy of v. If v is nil,
+// the returned BigFloat is invalid.
+func BigFloatFrom(v *big.Float) BigFloat {
+	if v != nil {
+		return BigFloat{
+			Float: new(big.Float).Copy(v),
+			Valid: true,
+		}
+	}
+	return BigFloat{}
+}
+
+// BigFloatFromZero creates a BigFloat from a copy of v. If v is nil or 0,
+// the returned BigFloat is invalid.
+func BigFloatFromZero(v *big.Float) BigFloat {
+	f := BigFloatFrom(v)
+	f.Valid = v != nil && v.Sign() != 0
+	return f
+}
+
+// Ptr returns a copy of the underlying value of f if f is valid, otherwise
+// returns nil. A nil underlying value is returned as 0.
+func (f BigFloat) Ptr() *big.Float {
+	if f.Valid {
+		return new(big.Float).Copy(f.float())
+	}
+	return nil
+}
+
+// Zero returns a copy of the underlying value of f if f is valid, otherwise
+// returns 0.
+func (f BigFloat) Zero() *big.Float {
+	if f.Valid {
+		return new(big.Float).Copy(f.float())
+	}
+	return new(big.Float)
+}
+
+// From sets the underlying value of f to a copy of v, and f becomes valid
+// if v is not nil, otherwise f becomes invalid.
+func (f *BigFloat) From(v *big.Float) {
+	f.assign(BigFloatFrom(v))
+}
+
+// FromZero invalidates f if v is nil or 0, otherwise it sets the underlying
+// value of f to a copy of v, and f becomes valid.
+func (f *BigFloat) FromZero(v *big.Float) {
+	f.assign(BigFloatFromZero(v))
+}
+
+// IsValid returns true if f is valid.
+func (f BigFloat) IsValid() bool {
+	return f.Valid
+}
+
+// Invalidate makes f invalid.
+func (f *BigFloat) Invalidate() {
+	f.Valid = false
+}
+
+// Interface returns a copy of the underlying value of f as *big.Float if f
+// is valid, otherwise nil.
+func (f BigFloat) Interface() interface{} {
+	if f.Valid {
+		return new(big.Float).Copy(f.float())
+	}
+	return nil
+}
+
+// SetInterface invalidates f if v is nil, otherwise if v's type is
+// *big.Float, it behaves like From. If v's type is any other type,
+// f becomes invalid, and a TypeError is returned.
+func (f *BigFloat) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case *big.Float:
+		f.From(value)
+		return nil
+	case nil:
+		f.Valid = false
+		return nil
+	default:
+		f.Valid = false
+		return makeTypeError("set", value, "*big.Float", "nil")
+	}
+}
+
+// String returns a string representation of f. If f is valid,
+// it returns the shortest decimal representation of the underlying value of
+// f which parses back to it at its precision, otherwise it returns
+// InvalidNullableString.
+func (f BigFloat) String() string {
+	if f.Valid {
+		return f.float().Text('g', -1)
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals f to a byte string representation. If f is valid,
+// it formats the underlying value of f like String does, otherwise it returns
+// nil. err is always nil.
+func (f BigFloat) MarshalText() (data []byte, err error) {
+	if f.Valid {
+		return []byte(f.float().Text('g', -1)), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of f to a JSON number if f is
+// valid, otherwise it returns the JSON null value. The number is formatted
+// like String does. If the underlying value of f is infinite,
+// a MarshalError is returned.
+func (f BigFloat) MarshalJSON() (data []byte, err error) {
+	if !f.Valid {
+		return jNull, nil
+	}
+	if f.float().IsInf() {
+		return nil, makeMarshalError("json", f)
+	}
+	return []byte(f.float().Text('g', -1)), nil
+}
+
+// Value returns the underlying value of f formatted like String does if f is
+// valid, otherwise nil. err is always nil.
+func (f BigFloat) Value() (v driver.Value, err error) {
+	if f.Valid {
+		return f.float().Text('g', -1), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates f if str is the empty string, otherwise it parses str into
+// the underlying value of f with the precision of f, and f becomes valid.
+// If str is not a valid string representation of a floating point number,
+// f becomes invalid and a ParseError is returned.
+func (f *BigFloat) Set(str string) error {
+	if str == "" {
+		f.Valid = false
+		return nil
+	}
+
+	if !f.parse(str) {
+		return makeParseError("parse", str, *f)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to f.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (f *BigFloat) UnmarshalText(text []byte) error {
+	if f.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *f)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to f.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, f becomes invalid. If the encoded JSON data
+// represent a JSON number, f becomes valid, and the underlying value of f is
+// set to the JSON number with the precision of f, without converting it to
+// float64 first. JSON strings are parsed like Set does, and a ParseError is
+// returned if they cannot be. Other JSON types produce a TypeError.
+// Malformed JSON produces an UnmarshalError.
+func (f *BigFloat) UnmarshalJSON(data []byte) error {
+	obj, ok := decodeJSONNumber(data)
+	if !ok {
+		f.Valid = false
+		return makeUnmarshalError("json", data, *f)
+	}
+	switch value := obj.(type) {
+	case json.Number:
+		if !f.parse(string(value)) {
+			return makeConversionError("json", value, *f)
+		}
+		return nil
+	case string:
+		if f.Set(value) != nil || value == "" {
+			f.Valid = false
+			return makeParseError("parse", value, *f)
+		}
+		return nil
+	case nil:
+		f.Valid = false
+		return nil
+	default:
+		f.Valid = false
+		return makeTypeError("json", value, "json.Number", "string", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is parsed like Set does, except that the empty string produces
+// a ParseError. If obj's type is float64 or int64, f becomes valid, and the
+// underlying value of f becomes the value of obj, unless obj is NaN, which a
+// big.Float cannot hold, in which case f becomes invalid, and a
+// ConversionError is returned. If obj is nil, f becomes invalid. If obj's
+// type is any other type, f becomes invalid, and a TypeError is returned.
+func (f *BigFloat) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case string:
+		return f.scanString(value)
+	case []byte:
+		return f.scanString(string(value))
+	case float64:
+		if math.IsNaN(value) {
+			f.Valid = false
+			return makeConversionError("sql", value, *f)
+		}
+		f.Float = new(big.Float).SetPrec(f.prec()).SetFloat64(value)
+		f.Valid = true
+		return nil
+	case int64:
+		f.Float = new(big.Float).SetPrec(f.prec()).SetInt64(value)
+		f.Valid = true
+		return nil
+	case nil:
+		f.Valid = false
+		return nil
+	default:
+		f.Valid = false
+		return makeTypeError("sql", value, "string", "[]byte", "float64",
+			"int64", "nil")
+	}
+}
+
+// scanString parses a number returned by a database driver as a string.
+// Unlike Set, the empty string is not accepted.
+func (f *BigFloat) scanString(str string) error {
+	if !f.parse(str) {
+		return makeParseError("sql", str, *f)
+	}
+	return nil
+}
+
+// parse sets the underlying value of f to str parsed in base 10 with the
+// precision of f, and returns the validity of f.
+func (f *BigFloat) parse(str string) bool {
+	v, _, err := big.ParseFloat(str, 10, f.prec(), big.ToNearestEven)
+	f.Float, f.Valid = v, err == nil
+	return f.Valid
+}
+
+// assign sets the underlying value and validity of f to those of v,
+// preserving the precision of f.
+func (f *BigFloat) assign(v BigFloat) {
+	v.Prec = f.Prec
+	*f = v
+}
+
+// prec returns the precision of the values parsed by f, where 0 becomes
+// defaultBigFloatPrec.
+func (f BigFloat) prec() uint {
+	if f.Prec == 0 {
+		return defaultBigFloatPrec
+	}
+	return f.Prec
+}
+
+// float returns the underlying value of f, where nil becomes zero.
+func (f BigFloat) float() *big.Float {
+	if f.Float == nil {
+		return new(big.Float)
+	}
+	return f.Float
+}
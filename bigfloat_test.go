@@ -0,0 +1,289 @@
+package null_test
+
+import (
+	"math"
+	"math/big"
+	"null"
+	"reflect"
+	"testing"
+)
+
+// precise is a string representation of a number which a float64 cannot
+// hold exactly.
+const precise = "1.00000000000000000000000000001"
+
+func TestBigFloatFrom(t *testing.T) {
+	v := big.NewFloat(1.5)
+
+	cases := []struct {
+		nullable null.BigFloat
+		string   string
+	}{
+		{null.BigFloatFrom(v), "1.5"},
+		{null.BigFloatFrom(nil), "<invalid>"},
+		{null.BigFloatFromZero(v), "1.5"},
+		{null.BigFloatFromZero(new(big.Float)), "<invalid>"},
+		{null.BigFloatFromZero(nil), "<invalid>"},
+		{null.BigFloat{Valid: true}, "0"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+
+	f := null.BigFloatFrom(v)
+	v.SetInt64(0)
+	if f.String() != "1.5" {
+		t.Fatalf("%s: source was not copied", t.Name())
+	}
+}
+
+func TestBigFloat_Ptr(t *testing.T) {
+	if p := (null.BigFloat{}).Ptr(); p != nil {
+		t.Fatalf("%s: invalid BigFloat produced %v", t.Name(), p)
+	}
+	if z := (null.BigFloat{}).Zero(); z.Sign() != 0 {
+		t.Fatalf("%s: invalid BigFloat produced %v", t.Name(), z)
+	}
+
+	f := null.BigFloatFrom(big.NewFloat(1.5))
+	f.Ptr().SetInt64(0)
+	f.Zero().SetInt64(0)
+	f.Interface().(*big.Float).SetInt64(0)
+	if f.String() != "1.5" {
+		t.Fatalf("%s: underlying value was modified", t.Name())
+	}
+}
+
+func TestBigFloat_MarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+	var p null.BigFloat
+	_ = p.Set(precise)
+
+	cases := []struct {
+		nullable null.BigFloat
+		json     []byte
+		errType  reflect.Type
+	}{
+		{p, []byte(precise), nilType},
+		{null.BigFloatFrom(big.NewFloat(-0.25)), []byte("-0.25"), nilType},
+		{null.BigFloatFrom(big.NewFloat(math.Inf(1))), nil, marshalErrType},
+		{null.BigFloat{}, []byte("null"), nilType},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestBigFloat_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.BigFloat
+		value    interface{}
+	}{
+		{null.BigFloatFrom(big.NewFloat(2.5)), "2.5"},
+		{null.BigFloat{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestBigFloat_Set(t *testing.T) {
+	var f null.BigFloat
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		result  string
+		errType reflect.Type
+	}{
+		{precise, precise, nilType},
+		{"-1e400", "-1e+400", nilType},
+		{"", "<invalid>", nilType},
+		{"1e99999999999", "<invalid>", parseErrType},
+		{"x", "<invalid>", parseErrType},
+	}
+
+	for n, c := range cases {
+		err := f.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.result != f.String() {
+			t.Fatalf(
+				"%s, case #%d: result mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.result, f.String(),
+			)
+		}
+		if f.Valid && f.Float.Prec() != 256 {
+			t.Fatalf(
+				"%s, case #%d: precision mismatch (expected 256, got %d)",
+				t.Name(), n+1, f.Float.Prec(),
+			)
+		}
+	}
+}
+
+func TestBigFloat_Prec(t *testing.T) {
+	f := null.BigFloat{Prec: 53}
+	if err := f.Set(precise); err != nil {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+	if f.Float.Prec() != 53 || f.String() != "1" {
+		t.Fatalf(
+			"%s: precision mismatch (expected 53, got %d, '%s')",
+			t.Name(), f.Float.Prec(), f.String(),
+		)
+	}
+	f.From(big.NewFloat(2))
+	if err := f.Scan(int64(3)); err != nil || f.Prec != 53 ||
+		f.Float.Prec() != 53 {
+		t.Fatalf("%s: precision not preserved (got %d)", t.Name(), f.Prec)
+	}
+}
+
+func TestBigFloat_UnmarshalText(t *testing.T) {
+	var f null.BigFloat
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(precise), true, nilType},
+		{nil, false, nilType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := f.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != f.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, f.Valid,
+			)
+		}
+	}
+}
+
+func TestBigFloat_UnmarshalJSON(t *testing.T) {
+	var f null.BigFloat
+	nilType := reflect.TypeOf(nil)
+	convErrType := reflect.TypeOf(null.ConversionError{})
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		result  string
+		errType reflect.Type
+	}{
+		{[]byte(precise), precise, nilType},
+		{[]byte(`"` + precise + `"`), precise, nilType},
+		{[]byte("null"), "<invalid>", nilType},
+		{[]byte("1e99999999999"), "<invalid>", convErrType},
+		{[]byte(`""`), "<invalid>", parseErrType},
+		{[]byte(`"x"`), "<invalid>", parseErrType},
+		{[]byte("false"), "<invalid>", typeErrType},
+		{nil, "<invalid>", unmarshalErrType},
+		{[]byte("x"), "<invalid>", unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := f.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.result != f.String() {
+			t.Fatalf(
+				"%s, case #%d: result mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.result, f.String(),
+			)
+		}
+	}
+}
+
+func TestBigFloat_Scan(t *testing.T) {
+	var f null.BigFloat
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	convErrType := reflect.TypeOf(null.ConversionError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		result  string
+		errType reflect.Type
+	}{
+		{precise, precise, nilType},
+		{[]byte(precise), precise, nilType},
+		{0.5, "0.5", nilType},
+		{int64(-3), "-3", nilType},
+		{math.Inf(-1), "-Inf", nilType},
+		{math.NaN(), "<invalid>", convErrType},
+		{nil, "<invalid>", nilType},
+		{"", "<invalid>", parseErrType},
+		{true, "<invalid>", typeErrType},
+	}
+
+	for n, c := range cases {
+		err := f.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.result != f.String() {
+			t.Fatalf(
+				"%s, case #%d: result mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.result, f.String(),
+			)
+		}
+	}
+}
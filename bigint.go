@@ -0,0 +1,264 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+)
+
+// BigInt implements a nullable big.Int, for integers which do not fit in 64
+// bits.
+type BigInt struct {
+	// Int holds the underlying big.Int value. A nil Int is equivalent to
+	// zero.
+	Int *big.Int
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// BigIntFrom creates a BigInt from a copy of v. If v is nil,
+// the returned BigInt is invalid.
+func BigIntFrom(v *big.Int) BigInt {
+	if v != nil {
+		return BigInt{
+			Int:   new(big.Int).Set(v),
+			Valid: true,
+		}
+	}
+	return BigInt{}
+}
+
+// BigIntFromInt64 creates a valid BigInt from v.
+func BigIntFromInt64(v int64) BigInt {
+	return BigInt{
+		Int:   big.NewInt(v),
+		Valid: true,
+	}
+}
+
+// BigIntFromZero creates a BigInt from a copy of v. If v is nil or 0,
+// the returned BigInt is invalid.
+func BigIntFromZero(v *big.Int) BigInt {
+	b := BigIntFrom(v)
+	b.Valid = v != nil && v.Sign() != 0
+	return b
+}
+
+// Ptr returns a copy of the underlying value of b if b is valid, otherwise
+// returns nil. A nil underlying value is returned as 0.
+func (b BigInt) Ptr() *big.Int {
+	if b.Valid {
+		return new(big.Int).Set(b.int())
+	}
+	return nil
+}
+
+// Zero returns a copy of the underlying value of b if b is valid, otherwise
+// returns 0.
+func (b BigInt) Zero() *big.Int {
+	if b.Valid {
+		return new(big.Int).Set(b.int())
+	}
+	return new(big.Int)
+}
+
+// From sets the underlying value of b to a copy of v, and b becomes valid
+// if v is not nil, otherwise b becomes invalid.
+func (b *BigInt) From(v *big.Int) {
+	*b = BigIntFrom(v)
+}
+
+// FromZero invalidates b if v is nil or 0, otherwise it sets the underlying
+// value of b to a copy of v, and b becomes valid.
+func (b *BigInt) FromZero(v *big.Int) {
+	*b = BigIntFromZero(v)
+}
+
+// IsValid returns true if b is valid.
+func (b BigInt) IsValid() bool {
+	return b.Valid
+}
+
+// Invalidate makes b invalid.
+func (b *BigInt) Invalidate() {
+	b.Valid = false
+}
+
+// Interface returns a copy of the underlying value of b as *big.Int if b is
+// valid, otherwise nil.
+func (b BigInt) Interface() interface{} {
+	if b.Valid {
+		return new(big.Int).Set(b.int())
+	}
+	return nil
+}
+
+// SetInterface invalidates b if v is nil, otherwise if v's type is *big.Int,
+// it behaves like From. If v's type is any other type, b becomes invalid,
+// and a TypeError is returned.
+func (b *BigInt) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case *big.Int:
+		b.From(value)
+		return nil
+	case nil:
+		b.Valid = false
+		return nil
+	default:
+		b.Valid = false
+		return makeTypeError("set", value, "*big.Int", "nil")
+	}
+}
+
+// String returns a string representation of b. If b is valid,
+// it returns the underlying value of b in base 10, otherwise it returns
+// InvalidNullableString.
+func (b BigInt) String() string {
+	if b.Valid {
+		return b.int().String()
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals b to a byte string representation. If b is valid,
+// it formats the underlying value of b in base 10, otherwise it returns nil.
+// err is always nil.
+func (b BigInt) MarshalText() (data []byte, err error) {
+	if b.Valid {
+		return []byte(b.int().String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of b to a JSON number if b is
+// valid, otherwise it returns the JSON null value. All the digits are
+// written, even if the number is beyond 2^53. err is always nil.
+func (b BigInt) MarshalJSON() (data []byte, err error) {
+	if b.Valid {
+		return []byte(b.int().String()), nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of b formatted in base 10 as a string
+// if b is valid, otherwise nil. err is always nil.
+func (b BigInt) Value() (v driver.Value, err error) {
+	if b.Valid {
+		return b.int().String(), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates b if str is the empty string, otherwise it parses str into
+// the underlying value of b, and b becomes valid. Like Int, str may have a
+// base prefix such as 0x. If str is not a valid string representation of an
+// integer, b becomes invalid and a ParseError is returned.
+func (b *BigInt) Set(str string) error {
+	if str == "" {
+		b.Valid = false
+		return nil
+	}
+
+	b.Int, b.Valid = new(big.Int).SetString(str, 0)
+	if !b.Valid {
+		return makeParseError("parse", str, *b)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to b.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (b *BigInt) UnmarshalText(text []byte) error {
+	if b.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *b)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to b.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, b becomes invalid. If the encoded JSON data
+// represent a JSON number, and it is an integer, b becomes valid, and the
+// underlying value of b is set to the JSON number, without converting it to
+// float64 first. JSON numbers in other notations, such as 1e3, are accepted
+// as long as they are integral, otherwise a ConversionError is returned.
+// JSON strings are parsed like Set does, and a ParseError is returned if
+// they cannot be. Other JSON types produce a TypeError. Malformed JSON
+// produces an UnmarshalError.
+func (b *BigInt) UnmarshalJSON(data []byte) error {
+	obj, ok := decodeJSONNumber(data)
+	if !ok {
+		b.Valid = false
+		return makeUnmarshalError("json", data, *b)
+	}
+	switch value := obj.(type) {
+	case json.Number:
+		b.Int, b.Valid = new(big.Int).SetString(string(value), 10)
+		if !b.Valid {
+			// accept integral numbers in other notations, such as 1e3
+			if d, ok := parseDecimal(string(value)); ok && d.Rat().IsInt() {
+				b.Int, b.Valid = d.Rat().Num(), true
+			}
+		}
+		if !b.Valid {
+			return makeConversionError("json", value, *b)
+		}
+		return nil
+	case string:
+		if b.Set(value) != nil || value == "" {
+			b.Valid = false
+			return makeParseError("parse", value, *b)
+		}
+		return nil
+	case nil:
+		b.Valid = false
+		return nil
+	default:
+		b.Valid = false
+		return makeTypeError("json", value, "json.Number", "string", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is parsed in base 10, b becomes valid, and the underlying value
+// of b is set accordingly, otherwise b becomes invalid, and a ParseError is
+// returned. If obj's type is int64, b becomes valid, and the underlying value
+// of b becomes the value of obj. If obj is nil, b becomes invalid. If obj's
+// type is any other type, b becomes invalid, and a TypeError is returned.
+func (b *BigInt) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case string:
+		return b.scanString(value)
+	case []byte:
+		return b.scanString(string(value))
+	case int64:
+		*b = BigIntFromInt64(value)
+		return nil
+	case nil:
+		b.Valid = false
+		return nil
+	default:
+		b.Valid = false
+		return makeTypeError("sql", value, "string", "[]byte", "int64", "nil")
+	}
+}
+
+// scanString parses an integer returned by a database driver as a string.
+func (b *BigInt) scanString(str string) error {
+	b.Int, b.Valid = new(big.Int).SetString(str, 10)
+	if !b.Valid {
+		return makeParseError("sql", str, *b)
+	}
+	return nil
+}
+
+// int returns the underlying value of b, where nil becomes zero.
+func (b BigInt) int() *big.Int {
+	if b.Int == nil {
+		return new(big.Int)
+	}
+	return b.Int
+}
@@ -0,0 +1,260 @@
+package null_test
+
+import (
+	"math/big"
+	"null"
+	"reflect"
+	"testing"
+)
+
+// beyond64 is a string representation of an integer beyond 2^64.
+const beyond64 = "123456789012345678901234567890"
+
+func TestBigIntFrom(t *testing.T) {
+	v := big.NewInt(42)
+
+	cases := []struct {
+		nullable null.BigInt
+		string   string
+	}{
+		{null.BigIntFrom(v), "42"},
+		{null.BigIntFrom(nil), "<invalid>"},
+		{null.BigIntFromInt64(-7), "-7"},
+		{null.BigIntFromZero(v), "42"},
+		{null.BigIntFromZero(new(big.Int)), "<invalid>"},
+		{null.BigIntFromZero(nil), "<invalid>"},
+		{null.BigInt{Valid: true}, "0"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+
+	b := null.BigIntFrom(v)
+	v.SetInt64(0)
+	if b.String() != "42" {
+		t.Fatalf("%s: source was not copied", t.Name())
+	}
+}
+
+func TestBigInt_Ptr(t *testing.T) {
+	if p := (null.BigInt{}).Ptr(); p != nil {
+		t.Fatalf("%s: invalid BigInt produced %v", t.Name(), p)
+	}
+	if z := (null.BigInt{}).Zero(); z.Sign() != 0 {
+		t.Fatalf("%s: invalid BigInt produced %v", t.Name(), z)
+	}
+	if p := null.BigIntFromInt64(3).Ptr(); p.Int64() != 3 {
+		t.Fatalf("%s: value mismatch (expected 3, got %v)", t.Name(), p)
+	}
+
+	b := null.BigIntFromInt64(3)
+	b.Ptr().SetInt64(0)
+	b.Zero().SetInt64(0)
+	b.Interface().(*big.Int).SetInt64(0)
+	if b.String() != "3" {
+		t.Fatalf("%s: underlying value was modified", t.Name())
+	}
+}
+
+func TestBigInt_MarshalJSON(t *testing.T) {
+	v, _ := new(big.Int).SetString(beyond64, 10)
+
+	cases := []struct {
+		nullable null.BigInt
+		json     []byte
+	}{
+		{null.BigIntFrom(v), []byte(beyond64)},
+		{null.BigInt{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestBigInt_Value(t *testing.T) {
+	v, _ := new(big.Int).SetString(beyond64, 10)
+
+	cases := []struct {
+		nullable null.BigInt
+		value    interface{}
+	}{
+		{null.BigIntFrom(v), beyond64},
+		{null.BigInt{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestBigInt_Set(t *testing.T) {
+	var b null.BigInt
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		result  string
+		errType reflect.Type
+	}{
+		{beyond64, beyond64, nilType},
+		{"-" + beyond64, "-" + beyond64, nilType},
+		{"0x10", "16", nilType},
+		{"", "<invalid>", nilType},
+		{"1.5", "<invalid>", parseErrType},
+		{"x", "<invalid>", parseErrType},
+	}
+
+	for n, c := range cases {
+		err := b.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.result != b.String() {
+			t.Fatalf(
+				"%s, case #%d: result mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.result, b.String(),
+			)
+		}
+	}
+}
+
+func TestBigInt_UnmarshalText(t *testing.T) {
+	var b null.BigInt
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(beyond64), true, nilType},
+		{nil, false, nilType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := b.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != b.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, b.Valid,
+			)
+		}
+	}
+}
+
+func TestBigInt_UnmarshalJSON(t *testing.T) {
+	var b null.BigInt
+	nilType := reflect.TypeOf(nil)
+	convErrType := reflect.TypeOf(null.ConversionError{})
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		result  string
+		errType reflect.Type
+	}{
+		{[]byte(beyond64), beyond64, nilType},
+		{[]byte(`"` + beyond64 + `"`), beyond64, nilType},
+		{[]byte("1e3"), "1000", nilType},
+		{[]byte("2.0"), "2", nilType},
+		{[]byte("null"), "<invalid>", nilType},
+		{[]byte("1.5"), "<invalid>", convErrType},
+		{[]byte(`""`), "<invalid>", parseErrType},
+		{[]byte(`"x"`), "<invalid>", parseErrType},
+		{[]byte("[]"), "<invalid>", typeErrType},
+		{nil, "<invalid>", unmarshalErrType},
+		{[]byte("x"), "<invalid>", unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := b.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.result != b.String() {
+			t.Fatalf(
+				"%s, case #%d: result mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.result, b.String(),
+			)
+		}
+	}
+}
+
+func TestBigInt_Scan(t *testing.T) {
+	var b null.BigInt
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		result  string
+		errType reflect.Type
+	}{
+		{beyond64, beyond64, nilType},
+		{[]byte(beyond64), beyond64, nilType},
+		{int64(-3), "-3", nilType},
+		{nil, "<invalid>", nilType},
+		{"", "<invalid>", parseErrType},
+		{"0x10", "<invalid>", parseErrType},
+		{1.5, "<invalid>", typeErrType},
+	}
+
+	for n, c := range cases {
+		err := b.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.result != b.String() {
+			t.Fatalf(
+				"%s, case #%d: result mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.result, b.String(),
+			)
+		}
+	}
+}
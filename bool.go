@@ -82,6 +82,44 @@ func (b *Bool) FromZero(v bool) {
 	b.Bool = v
 }
 
+// IsValid returns true if b is valid.
+func (b Bool) IsValid() bool {
+	return b.Valid
+}
+
+// Invalidate makes b invalid.
+func (b *Bool) Invalidate() {
+	b.Valid = false
+}
+
+// Interface returns the underlying value of b as bool if b is valid,
+// otherwise nil.
+func (b Bool) Interface() interface{} {
+	if b.Valid {
+		return b.Bool
+	}
+	return nil
+}
+
+// SetInterface invalidates b if v is nil, otherwise if v's type is bool,
+// it sets the underlying value of b to v, and b becomes valid.
+// If v's type is any other type, b becomes invalid, and a TypeError is
+// returned.
+func (b *Bool) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case bool:
+		b.Bool = value
+		b.Valid = true
+		return nil
+	case nil:
+		b.Valid = false
+		return nil
+	default:
+		b.Valid = false
+		return makeTypeError("set", value, "bool", "nil")
+	}
+}
+
 // String returns a string representation of b. If b is valid,
 // it returns either "true" or "false", otherwise it returns
 // InvalidNullableString.
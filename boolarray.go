@@ -0,0 +1,253 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strconv"
+)
+
+// BoolArray implements a nullable []Bool, meant to be used with PostgreSQL
+// boolean[] columns. Elements are Bool values, so that NULL elements are
+// represented by invalid Bool values. BoolArray is represented in text and SQL
+// by a PostgreSQL array literal, such as {t,NULL,f}, and in JSON by an array
+// holding null for NULL elements.
+type BoolArray struct {
+	// Array holds the underlying []Bool value.
+	Array []Bool
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// BoolArrayFrom creates a valid BoolArray from v.
+func BoolArrayFrom(v []Bool) BoolArray {
+	return BoolArrayFromPtr(&v)
+}
+
+// BoolArrayFromPtr creates a BoolArray from pointer p. If p is nil,
+// the returned BoolArray is invalid.
+func BoolArrayFromPtr(p *[]Bool) BoolArray {
+	if p != nil {
+		return BoolArray{
+			Array: *p,
+			Valid: true,
+		}
+	}
+	return BoolArray{}
+}
+
+// BoolArrayFromZero creates a BoolArray from v. If v is nil, the returned
+// BoolArray is invalid. An empty but non-nil v produces a valid BoolArray.
+func BoolArrayFromZero(v []Bool) BoolArray {
+	return BoolArray{
+		Array: v,
+		Valid: v != nil,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of a if a is valid,
+// otherwise returns nil.
+func (a BoolArray) Ptr() *[]Bool {
+	if a.Valid {
+		return &a.Array
+	}
+	return nil
+}
+
+// Zero returns the underlying value of a if a is valid, otherwise returns
+// nil.
+func (a BoolArray) Zero() []Bool {
+	if a.Valid {
+		return a.Array
+	}
+	return nil
+}
+
+// From sets the underlying value of a to v. a becomes valid.
+func (a *BoolArray) From(v []Bool) {
+	a.Array = v
+	a.Valid = true
+}
+
+// FromPtr invalidates a if p is nil, otherwise it sets the underlying value
+// of a to the value pointed to by p, and a becomes valid.
+func (a *BoolArray) FromPtr(p *[]Bool) {
+	a.Valid = p != nil
+	if p != nil {
+		a.Array = *p
+	}
+}
+
+// FromZero invalidates a if v is nil, otherwise it sets the underlying value
+// of a to v, and a becomes valid.
+func (a *BoolArray) FromZero(v []Bool) {
+	a.Array = v
+	a.Valid = v != nil
+}
+
+// IsValid returns true if a is valid.
+func (a BoolArray) IsValid() bool {
+	return a.Valid
+}
+
+// Invalidate makes a invalid.
+func (a *BoolArray) Invalidate() {
+	a.Valid = false
+}
+
+// Interface returns the underlying value of a as []Bool if a is valid,
+// otherwise nil.
+func (a BoolArray) Interface() interface{} {
+	if a.Valid {
+		return a.Array
+	}
+	return nil
+}
+
+// SetInterface invalidates a if v is nil, otherwise if v's type is
+// []Bool, it sets the underlying value of a to v, and a becomes valid.
+// If v's type is any other type, a becomes invalid, and a TypeError is
+// returned.
+func (a *BoolArray) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case []Bool:
+		a.From(value)
+		return nil
+	case nil:
+		a.Valid = false
+		return nil
+	default:
+		a.Valid = false
+		return makeTypeError("set", value, "[]Bool", "nil")
+	}
+}
+
+// String returns a string representation of a. If a is valid,
+// it returns the PostgreSQL array literal holding the elements of a,
+// otherwise it returns InvalidNullableString.
+func (a BoolArray) String() string {
+	if a.Valid {
+		return a.literal()
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals a to a byte string representation. If a is valid,
+// it returns the PostgreSQL array literal holding the elements of a,
+// otherwise it returns nil. err is always nil.
+func (a BoolArray) MarshalText() (data []byte, err error) {
+	if a.Valid {
+		return []byte(a.literal()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of a to a JSON array if a is
+// valid, even if the underlying value of a is nil, otherwise it returns the
+// JSON null value. Invalid elements are encoded as the JSON null value.
+// err is always nil.
+func (a BoolArray) MarshalJSON() (data []byte, err error) {
+	if !a.Valid {
+		return jNull, nil
+	}
+	v := a.Array
+	if v == nil {
+		v = []Bool{}
+	}
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return nil, makeMarshalError("json", a)
+	}
+	return bytes, nil
+}
+
+// Value returns the PostgreSQL array literal holding the elements of a if
+// a is valid, otherwise nil. err is always nil.
+func (a BoolArray) Value() (v driver.Value, err error) {
+	if a.Valid {
+		return a.literal(), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates a if str is the empty string, otherwise it parses str,
+// which holds a one-dimensional PostgreSQL array literal, into the
+// underlying value of a, and a becomes valid. Unquoted NULL elements become
+// invalid elements. If str holds a multi-dimensional array, a becomes
+// invalid and a DimensionError is returned. If str cannot be parsed,
+// a becomes invalid and a ParseError is returned.
+func (a *BoolArray) Set(str string) error {
+	if str == "" {
+		a.Valid = false
+		return nil
+	}
+	return a.parse("parse", str)
+}
+
+// UnmarshalText unmarshals from a byte string to a.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError or a DimensionError in case text cannot be parsed.
+func (a *BoolArray) UnmarshalText(text []byte) error {
+	if a.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *a)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to a.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, a becomes invalid. If the encoded JSON data
+// represent a JSON array, a becomes valid, and the underlying value of a is
+// set to a new slice holding the elements of the array, which are
+// unmarshaled like Bool.UnmarshalJSON does. Malformed JSON, other JSON types,
+// or elements which Bool.UnmarshalJSON rejects produce an UnmarshalError.
+func (a *BoolArray) UnmarshalJSON(data []byte) error {
+	var v []Bool
+	if json.Unmarshal(data, &v) != nil {
+		a.Valid = false
+		return makeUnmarshalError("json", data, *a)
+	}
+	a.Array, a.Valid = v, v != nil
+	return nil
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is parsed like Set does, except that the empty string produces
+// a ParseError. If obj is nil, a becomes invalid. If obj's type is any other
+// type, a becomes invalid, and a TypeError is returned.
+func (a *BoolArray) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case string:
+		return a.parse("sql", value)
+	case []byte:
+		return a.parse("sql", string(value))
+	case nil:
+		a.Valid = false
+		return nil
+	default:
+		a.Valid = false
+		return makeTypeError("sql", value, "string", "[]byte", "nil")
+	}
+}
+
+// literal returns the PostgreSQL array literal holding the elements of a.
+func (a BoolArray) literal() string {
+	return formatArrayOf(a.Array, func(e Bool) (string, bool) {
+		return strconv.FormatBool(e.Bool), e.Valid
+	})
+}
+
+// parse parses str as a one-dimensional PostgreSQL array literal into the
+// underlying value of a. The returned errors use the given prefix.
+func (a *BoolArray) parse(prefix, str string) error {
+	v, err := parseArrayOf(prefix, str, *a, func(e arrayElem) (Bool, bool) {
+		if e.null {
+			return Bool{}, true
+		}
+		v, err := strconv.ParseBool(e.str)
+		return BoolFrom(v), err == nil
+	})
+	a.Array, a.Valid = v, err == nil
+	return err
+}
@@ -0,0 +1,226 @@
+package null_test
+
+import (
+	"null"
+	"reflect"
+	"testing"
+)
+
+func TestBoolArray_String(t *testing.T) {
+	cases := []struct {
+		nullable null.BoolArray
+		string   string
+	}{
+		{
+			null.BoolArrayFrom([]null.Bool{
+				null.BoolFrom(true), {}, null.BoolFrom(false),
+			}),
+			"{true,NULL,false}",
+		},
+		{null.BoolArrayFrom([]null.Bool{}), "{}"},
+		{null.BoolArray{}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestBoolArray_MarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+
+	cases := []struct {
+		nullable null.BoolArray
+		json     []byte
+		errType  reflect.Type
+	}{
+		{
+			null.BoolArrayFrom([]null.Bool{null.BoolFrom(true), {}}),
+			[]byte("[true,null]"),
+			nilType,
+		},
+		{null.BoolArrayFrom(nil), []byte("[]"), nilType},
+		{null.BoolArray{}, []byte("null"), nilType},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestBoolArray_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.BoolArray
+		value    interface{}
+	}{
+		{
+			null.BoolArrayFrom([]null.Bool{null.BoolFrom(false), {}}),
+			"{false,NULL}",
+		},
+		{null.BoolArray{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestBoolArray_Set(t *testing.T) {
+	var a null.BoolArray
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	dimErrType := reflect.TypeOf(null.DimensionError{})
+
+	cases := []struct {
+		string  string
+		valid   bool
+		value   []null.Bool
+		errType reflect.Type
+	}{
+		{
+			"{t,NULL,f,true,false}",
+			true,
+			[]null.Bool{
+				null.BoolFrom(true), {}, null.BoolFrom(false),
+				null.BoolFrom(true), null.BoolFrom(false),
+			},
+			nilType,
+		},
+		{"{yes}", false, nil, parseErrType},
+		{"{}", true, []null.Bool{}, nilType},
+		{"", false, nil, nilType},
+		{"{NULL,}", false, nil, parseErrType},
+		{"{{NULL}}", false, nil, dimErrType},
+	}
+
+	for n, c := range cases {
+		err := a.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != a.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, a.Valid,
+			)
+		}
+		if a.Valid && !reflect.DeepEqual(c.value, a.Array) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, a.Array,
+			)
+		}
+	}
+}
+
+func TestBoolArray_UnmarshalJSON(t *testing.T) {
+	var a null.BoolArray
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		value   []null.Bool
+		errType reflect.Type
+	}{
+		{
+			[]byte("[true,null]"),
+			true,
+			[]null.Bool{null.BoolFrom(true), {}},
+			nilType,
+		},
+		{[]byte("[1]"), false, nil, unmarshalErrType},
+		{[]byte("[]"), true, []null.Bool{}, nilType},
+		{[]byte("null"), false, nil, nilType},
+		{[]byte(`[{}]`), false, nil, unmarshalErrType},
+		{[]byte("x"), false, nil, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := a.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != a.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, a.Valid,
+			)
+		}
+		if a.Valid && !reflect.DeepEqual(c.value, a.Array) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, a.Array,
+			)
+		}
+	}
+}
+
+func TestBoolArray_Scan(t *testing.T) {
+	var a null.BoolArray
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{"{t,NULL}", true, nilType},
+		{[]byte("{f}"), true, nilType},
+		{"{x}", false, parseErrType},
+		{nil, false, nilType},
+		{"", false, parseErrType},
+		{int64(1), false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := a.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != a.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, a.Valid,
+			)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// BytesEncoding selects the text encoding of Bytes.
+type BytesEncoding int
+
+const (
+	// Base64Encoding encodes Bytes with standard base64 encoding, as defined
+	// in RFC 4648.
+	Base64Encoding BytesEncoding = iota
+
+	// HexEncoding encodes Bytes as a string of hexadecimal digits.
+	HexEncoding
+)
+
+// encode returns the text representation of v according to e.
+func (e BytesEncoding) encode(v []byte) string {
+	if e == HexEncoding {
+		return hex.EncodeToString(v)
+	}
+	return base64.StdEncoding.EncodeToString(v)
+}
+
+// decode parses str according to e.
+func (e BytesEncoding) decode(str string) ([]byte, error) {
+	if e == HexEncoding {
+		return hex.DecodeString(str)
+	}
+	return base64.StdEncoding.DecodeString(str)
+}
+
+// Bytes implements a nullable []byte.
+type Bytes struct {
+	// Bytes holds the underlying []byte value.
+	Bytes []byte
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+
+	// Encoding holds the encoding used by the String, MarshalText, Set and
+	// UnmarshalText methods. It defaults to Base64Encoding, and it is
+	// preserved by all the methods which modify b. JSON always uses standard
+	// base64 encoding, like encoding/json does for []byte.
+	Encoding BytesEncoding
+}
+
+// BytesFrom creates a valid Bytes from v.
+func BytesFrom(v []byte) Bytes {
+	return BytesFromPtr(&v)
+}
+
+// BytesFromPtr creates a Bytes from pointer p. If p is nil,
+// the returned Bytes is invalid.
+func BytesFromPtr(p *[]byte) Bytes {
+	if p != nil {
+		return Bytes{
+			Bytes: *p,
+			Valid: true,
+		}
+	}
+	return Bytes{}
+}
+
+// BytesFromZero creates a Bytes from v. If v is empty,
+// the returned Bytes is invalid.
+func BytesFromZero(v []byte) Bytes {
+	return Bytes{
+		Bytes: v,
+		Valid: len(v) > 0,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of b if b is valid,
+// otherwise returns nil.
+func (b Bytes) Ptr() *[]byte {
+	if b.Valid {
+		return &b.Bytes
+	}
+	return nil
+}
+
+// Zero returns the underlying value of b if b is valid, otherwise
+// returns nil.
+func (b Bytes) Zero() []byte {
+	if b.Valid {
+		return b.Bytes
+	}
+	return nil
+}
+
+// From sets the underlying value of b to v. b becomes valid.
+func (b *Bytes) From(v []byte) {
+	b.Valid = true
+	b.Bytes = v
+}
+
+// FromPtr invalidates b if p is nil, otherwise it sets the underlying value
+// of b to the value pointed to by p, and b becomes valid.
+func (b *Bytes) FromPtr(p *[]byte) {
+	b.Valid = p != nil
+	if p != nil {
+		b.Bytes = *p
+	}
+}
+
+// FromZero invalidates b if v is empty,
+// otherwise it sets the underlying value of b to v, and b becomes valid.
+func (b *Bytes) FromZero(v []byte) {
+	b.Valid = len(v) > 0
+	b.Bytes = v
+}
+
+// IsValid returns true if b is valid.
+func (b Bytes) IsValid() bool {
+	return b.Valid
+}
+
+// Invalidate makes b invalid.
+func (b *Bytes) Invalidate() {
+	b.Valid = false
+}
+
+// Interface returns the underlying value of b as []byte if b is valid,
+// otherwise nil.
+func (b Bytes) Interface() interface{} {
+	if b.Valid {
+		return b.Bytes
+	}
+	return nil
+}
+
+// SetInterface invalidates b if v is nil, otherwise if v's type is []byte,
+// it sets the underlying value of b to v, and b becomes valid.
+// If v's type is any other type, b becomes invalid, and a TypeError is
+// returned.
+func (b *Bytes) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case []byte:
+		b.Bytes = value
+		b.Valid = true
+		return nil
+	case nil:
+		b.Valid = false
+		return nil
+	default:
+		b.Valid = false
+		return makeTypeError("set", value, "[]byte", "nil")
+	}
+}
+
+// String returns a string representation of b. If b is valid,
+// it encodes the underlying value of b according to the
+// Encoding of b, otherwise it returns InvalidNullableString.
+func (b Bytes) String() string {
+	if b.Valid {
+		return b.Encoding.encode(b.Bytes)
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals b to a byte string representation.
+// If b is valid, it encodes the underlying value of b according to the
+// Encoding of b, otherwise it returns nil. err is always nil.
+func (b Bytes) MarshalText() (data []byte, err error) {
+	if b.Valid {
+		return []byte(b.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of b to a JSON string holding
+// its standard base64 encoding if b is valid, otherwise it returns the JSON
+// null value. err is always nil.
+func (b Bytes) MarshalJSON() (data []byte, err error) {
+	if b.Valid {
+		bytes, err := json.Marshal(b.Bytes)
+		if err != nil {
+			// this should never happen
+			panic(err)
+		}
+		if b.Bytes == nil {
+			// json.Marshal encodes nil slices as null
+			return []byte(`""`), nil
+		}
+		return bytes, nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of b if b is valid,
+// otherwise nil. err is always nil.
+func (b Bytes) Value() (v driver.Value, err error) {
+	if b.Valid {
+		if b.Bytes == nil {
+			return []byte{}, nil
+		}
+		return b.Bytes, nil
+	}
+	return nil, nil
+}
+
+// Set invalidates b if str is the empty string, otherwise it decodes str
+// according to the Encoding of b into the underlying value of b,
+// and b becomes valid. If str cannot be decoded, b becomes invalid and a
+// ParseError is returned.
+func (b *Bytes) Set(str string) error {
+	var err error
+	b.Bytes, err = b.Encoding.decode(str)
+	b.Valid = err == nil && str != ""
+	if str == "" || err == nil {
+		return nil
+	}
+
+	return makeParseError("parse", str, b.Bytes)
+}
+
+// UnmarshalText unmarshals from a byte string to b.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be decoded.
+func (b *Bytes) UnmarshalText(text []byte) error {
+	if b.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *b)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to b.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, b becomes invalid. If the encoded JSON data
+// represent a JSON string holding standard base64 encoded data, b becomes
+// valid, and the underlying value of b is set to the decoded data. If the
+// JSON string is not valid base64, a ParseError is returned.
+// Other JSON types produce a TypeError. Malformed JSON produces an
+// UnmarshalError.
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	var obj interface{}
+	var err error
+	if json.Unmarshal(data, &obj) != nil {
+		b.Valid = false
+		return makeUnmarshalError("json", data, *b)
+	}
+	switch value := obj.(type) {
+	case string:
+		b.Bytes, err = base64.StdEncoding.DecodeString(value)
+		b.Valid = err == nil
+		if b.Valid {
+			return nil
+		}
+		return makeParseError("parse", value, b.Bytes)
+	case nil:
+		b.Valid = false
+		return nil
+	default:
+		b.Valid = false
+		return makeTypeError("json", value, "string", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is []byte or
+// string, b becomes valid, and the underlying value of b becomes a copy of
+// obj, so that it remains valid after the driver reuses its buffer.
+// If obj is nil, b becomes invalid. If obj's type is any other type,
+// b becomes invalid, and a TypeError is returned.
+func (b *Bytes) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case []byte:
+		b.Bytes = append([]byte{}, value...)
+		b.Valid = true
+		return nil
+	case string:
+		b.Bytes = []byte(value)
+		b.Valid = true
+		return nil
+	case nil:
+		b.Valid = false
+		return nil
+	default:
+		b.Valid = false
+		return makeTypeError("sql", value, "[]byte", "string", "nil")
+	}
+}
@@ -0,0 +1,492 @@
+package null_test
+
+import (
+	"null"
+	"reflect"
+	"testing"
+)
+
+func bytesp(v []byte) *[]byte {
+	return &v
+}
+
+func TestBytesFrom(t *testing.T) {
+	cases := []struct {
+		literal []byte
+		valid   bool
+	}{
+		{nil, true},
+		{[]byte{}, true},
+		{[]byte("foo"), true},
+	}
+
+	for n, c := range cases {
+		b := null.BytesFrom(c.literal)
+		if c.valid != b.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, b.Valid,
+			)
+		}
+		if !reflect.DeepEqual(c.literal, b.Bytes) {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.literal, b.Bytes,
+			)
+		}
+	}
+}
+
+func TestBytesFromPtr(t *testing.T) {
+	cases := []struct {
+		ptr   *[]byte
+		valid bool
+	}{
+		{bytesp([]byte("foo")), true},
+		{bytesp(nil), true},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		b := null.BytesFromPtr(c.ptr)
+		if c.valid != b.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, b.Valid,
+			)
+		}
+		if !b.Valid {
+			continue
+		}
+
+		if !reflect.DeepEqual(*c.ptr, b.Bytes) {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, *c.ptr, b.Bytes,
+			)
+		}
+	}
+}
+
+func TestBytesFromZero(t *testing.T) {
+	cases := []struct {
+		literal []byte
+		valid   bool
+	}{
+		{nil, false},
+		{[]byte{}, false},
+		{[]byte("foo"), true},
+	}
+
+	for n, c := range cases {
+		b := null.BytesFromZero(c.literal)
+		if c.valid != b.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, b.Valid,
+			)
+		}
+	}
+}
+
+func TestBytes_Ptr(t *testing.T) {
+	cases := []struct {
+		nullable null.Bytes
+	}{
+		{null.Bytes{Bytes: []byte("foo"), Valid: true}},
+		{null.Bytes{}},
+		{null.Bytes{Bytes: []byte("bar"), Valid: false}},
+	}
+
+	for n, c := range cases {
+		p := c.nullable.Ptr()
+		if c.nullable.Valid {
+			if p == nil {
+				t.Fatalf(
+					"%s, case #%d: nil pointer returned",
+					t.Name(), n+1,
+				)
+			}
+			if !reflect.DeepEqual(c.nullable.Bytes, *p) {
+				t.Fatalf(
+					"%s, case #%d: literal mismatch (expected '%s', got '%s')",
+					t.Name(), n+1, c.nullable.Bytes, *p,
+				)
+			}
+		} else {
+			if p != nil {
+				t.Fatalf(
+					"%s, case #%d: non-nil pointer returned",
+					t.Name(), n+1,
+				)
+			}
+		}
+	}
+}
+
+func TestBytes_Zero(t *testing.T) {
+	cases := []struct {
+		nullable null.Bytes
+		literal  []byte
+	}{
+		{null.Bytes{Bytes: []byte("foo"), Valid: true}, []byte("foo")},
+		{null.Bytes{}, nil},
+		{null.Bytes{Bytes: []byte("bar"), Valid: false}, nil},
+	}
+
+	for n, c := range cases {
+		b := c.nullable.Zero()
+		if !reflect.DeepEqual(c.literal, b) {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.literal, b,
+			)
+		}
+	}
+}
+
+func TestBytes_FromZero(t *testing.T) {
+	var b null.Bytes
+	cases := []struct {
+		literal []byte
+		valid   bool
+	}{
+		{[]byte("foo"), true},
+		{[]byte{}, false},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		b.FromZero(c.literal)
+		if c.valid != b.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, b.Valid,
+			)
+		}
+	}
+}
+
+func TestBytes_String(t *testing.T) {
+	cases := []struct {
+		encoding null.BytesEncoding
+		nullable null.Bytes
+		string   string
+	}{
+		{null.Base64Encoding, null.BytesFrom([]byte("foo")), "Zm9v"},
+		{null.Base64Encoding, null.BytesFrom(nil), ""},
+		{null.HexEncoding, null.BytesFrom([]byte("foo")), "666f6f"},
+		{null.Base64Encoding, null.Bytes{}, "<invalid>"},
+		{null.HexEncoding, null.Bytes{}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		c.nullable.Encoding = c.encoding
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestBytes_MarshalText(t *testing.T) {
+	cases := []struct {
+		encoding null.BytesEncoding
+		nullable null.Bytes
+		bytes    []byte
+	}{
+		{null.Base64Encoding, null.BytesFrom([]byte("foo")), []byte("Zm9v")},
+		{null.HexEncoding, null.BytesFrom([]byte("foo")), []byte("666f6f")},
+		{null.Base64Encoding, null.Bytes{}, nil},
+	}
+
+	for n, c := range cases {
+		c.nullable.Encoding = c.encoding
+		b, err := c.nullable.MarshalText()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.bytes, b) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(b),
+			)
+		}
+	}
+}
+
+func TestBytes_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		nullable null.Bytes
+		json     []byte
+	}{
+		{null.BytesFrom([]byte("foo")), []byte(`"Zm9v"`)},
+		{null.BytesFrom([]byte{}), []byte(`""`)},
+		{null.BytesFrom(nil), []byte(`""`)},
+		{
+			null.Bytes{
+				Bytes:    []byte("foo"),
+				Valid:    true,
+				Encoding: null.HexEncoding,
+			},
+			[]byte(`"Zm9v"`),
+		},
+		{null.Bytes{}, []byte("null")},
+		{null.Bytes{Bytes: []byte("foo"), Valid: false}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestBytes_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.Bytes
+		value    interface{}
+	}{
+		{null.BytesFrom([]byte("foo")), []byte("foo")},
+		{null.BytesFrom(nil), []byte{}},
+		{null.Bytes{}, nil},
+		{null.Bytes{Bytes: []byte("foo"), Valid: false}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestBytes_Set(t *testing.T) {
+	var b null.Bytes
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		encoding null.BytesEncoding
+		string   string
+		literal  []byte
+		valid    bool
+		errType  reflect.Type
+	}{
+		{null.Base64Encoding, "Zm9v", []byte("foo"), true, nilType},
+		{null.Base64Encoding, "", nil, false, nilType},
+		{null.Base64Encoding, "Zm9", nil, false, parseErrType},
+		{null.HexEncoding, "666f6f", []byte("foo"), true, nilType},
+		{null.HexEncoding, "", nil, false, nilType},
+		{null.HexEncoding, "Zm9v", nil, false, parseErrType},
+	}
+
+	for n, c := range cases {
+		b.Encoding = c.encoding
+		err := b.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != b.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, b.Valid,
+			)
+		}
+		if !b.Valid {
+			continue
+		}
+
+		if !reflect.DeepEqual(c.literal, b.Bytes) {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.literal, b.Bytes,
+			)
+		}
+	}
+}
+
+func TestBytes_Encoding(t *testing.T) {
+	b := null.Bytes{Encoding: null.HexEncoding}
+	if err := b.UnmarshalText([]byte("666f6f")); err != nil {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+	if b.Encoding != null.HexEncoding || b.String() != "666f6f" {
+		t.Fatalf(
+			"%s: encoding not preserved (got %v, '%s')",
+			t.Name(), b.Encoding, b.String(),
+		)
+	}
+	if other := null.BytesFrom(b.Bytes); other.String() != "Zm9v" {
+		t.Fatalf(
+			"%s: default encoding mismatch (expected 'Zm9v', got '%s')",
+			t.Name(), other.String(),
+		)
+	}
+}
+
+func TestBytes_UnmarshalText(t *testing.T) {
+	var b null.Bytes
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		literal []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("Zm9v"), []byte("foo"), true, nilType},
+		{nil, nil, false, nilType},
+		{[]byte(""), nil, false, nilType},
+		{[]byte("x"), nil, false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := b.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != b.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, b.Valid,
+			)
+		}
+		if !b.Valid {
+			continue
+		}
+
+		if !reflect.DeepEqual(c.literal, b.Bytes) {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.literal, b.Bytes,
+			)
+		}
+	}
+}
+
+func TestBytes_UnmarshalJSON(t *testing.T) {
+	var b null.Bytes
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		literal []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`"Zm9v"`), []byte("foo"), true, nilType},
+		{[]byte(`""`), []byte{}, true, nilType},
+		{[]byte("null"), nil, false, nilType},
+		{[]byte(`"Zm9"`), nil, false, parseErrType},
+		{[]byte("1"), nil, false, typeErrType},
+		{nil, nil, false, unmarshalErrType},
+		{[]byte("x"), nil, false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := b.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != b.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, b.Valid,
+			)
+		}
+		if !b.Valid {
+			continue
+		}
+
+		if !reflect.DeepEqual(c.literal, b.Bytes) {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.literal, b.Bytes,
+			)
+		}
+	}
+}
+
+func TestBytes_Scan(t *testing.T) {
+	var b null.Bytes
+	nilType := reflect.TypeOf(nil)
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		literal []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("foo"), []byte("foo"), true, nilType},
+		{[]byte{}, []byte{}, true, nilType},
+		{"foo", []byte("foo"), true, nilType},
+		{nil, nil, false, nilType},
+		{int64(1), nil, false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := b.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != b.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, b.Valid,
+			)
+		}
+		if !b.Valid {
+			continue
+		}
+
+		if !reflect.DeepEqual(c.literal, b.Bytes) {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.literal, b.Bytes,
+			)
+		}
+	}
+
+	src := []byte("foo")
+	b.Scan(src)
+	src[0] = 'b'
+	if string(b.Bytes) != "foo" {
+		t.Fatalf(
+			"%s: driver buffer is shared (expected 'foo', got '%s')",
+			t.Name(), b.Bytes,
+		)
+	}
+}
@@ -0,0 +1,370 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ByteSize implements a nullable count of bytes, such as a size limit.
+// Its text representation is an integer or decimal number followed by an
+// optional unit, such as 512, 1.5GB or 10 MiB. SI units (kB, MB, GB, TB, PB,
+// EB) are powers of 1000, IEC units (KiB, MiB, GiB, TiB, PiB, EiB) are powers
+// of 1024, and B stands for bytes. Units are matched case-insensitively.
+// Counts of bytes are never negative: every constructor and method which
+// sets the underlying value makes a ByteSize invalid instead, so that the
+// output of String, MarshalText and MarshalJSON can always be parsed back.
+type ByteSize struct {
+	// Bytes holds the underlying count of bytes. It should not be set to a
+	// negative value directly.
+	Bytes int64
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// byteSizeUnits holds the units of ByteSize, from the largest to the
+// smallest.
+var byteSizeUnits = []struct {
+	name string
+	size int64
+}{
+	{"EiB", 1 << 60},
+	{"EB", 1e18},
+	{"PiB", 1 << 50},
+	{"PB", 1e15},
+	{"TiB", 1 << 40},
+	{"TB", 1e12},
+	{"GiB", 1 << 30},
+	{"GB", 1e9},
+	{"MiB", 1 << 20},
+	{"MB", 1e6},
+	{"KiB", 1 << 10},
+	{"kB", 1e3},
+	{"B", 1},
+}
+
+// ByteSizeFrom creates a valid ByteSize from v. If v is negative,
+// the returned ByteSize is invalid.
+func ByteSizeFrom(v int64) ByteSize {
+	return ByteSizeFromPtr(&v)
+}
+
+// ByteSizeFromPtr creates a ByteSize from pointer p. If p is nil, or it
+// points to a negative value, the returned ByteSize is invalid.
+func ByteSizeFromPtr(p *int64) ByteSize {
+	if p != nil {
+		return ByteSize{
+			Bytes: *p,
+			Valid: *p >= 0,
+		}
+	}
+	return ByteSize{}
+}
+
+// ByteSizeFromZero creates a ByteSize from v. If v is 0 or negative,
+// the returned ByteSize is invalid.
+func ByteSizeFromZero(v int64) ByteSize {
+	return ByteSize{
+		Bytes: v,
+		Valid: v > 0,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of b if b is valid,
+// otherwise returns nil.
+func (b ByteSize) Ptr() *int64 {
+	if b.Valid {
+		return &b.Bytes
+	}
+	return nil
+}
+
+// Zero returns the underlying value of b if b is valid, otherwise
+// returns 0.
+func (b ByteSize) Zero() int64 {
+	if b.Valid {
+		return b.Bytes
+	}
+	return 0
+}
+
+// From sets the underlying value of b to v. b becomes valid, unless v is
+// negative, in which case b becomes invalid.
+func (b *ByteSize) From(v int64) {
+	b.Valid = v >= 0
+	b.Bytes = v
+}
+
+// FromPtr invalidates b if p is nil, otherwise it sets the underlying value
+// of b to the value pointed to by p like From does.
+func (b *ByteSize) FromPtr(p *int64) {
+	b.Valid = p != nil && *p >= 0
+	if p != nil {
+		b.Bytes = *p
+	}
+}
+
+// FromZero invalidates b if v is 0 or negative, otherwise it sets the
+// underlying value of b to v, and b becomes valid.
+func (b *ByteSize) FromZero(v int64) {
+	b.Valid = v > 0
+	b.Bytes = v
+}
+
+// IsValid returns true if b is valid.
+func (b ByteSize) IsValid() bool {
+	return b.Valid
+}
+
+// Invalidate makes b invalid.
+func (b *ByteSize) Invalidate() {
+	b.Valid = false
+}
+
+// Interface returns the underlying value of b as int64 if b is valid,
+// otherwise nil.
+func (b ByteSize) Interface() interface{} {
+	if b.Valid {
+		return b.Bytes
+	}
+	return nil
+}
+
+// SetInterface invalidates b if v is nil, otherwise if v's type is int64,
+// it sets the underlying value of b to v, and b becomes valid, unless v is
+// negative, in which case b becomes invalid, and a ConversionError is
+// returned. If v's type is any other type, b becomes invalid, and a
+// TypeError is returned.
+func (b *ByteSize) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case int64:
+		b.From(value)
+		if !b.Valid {
+			return makeConversionError("set", value, *b)
+		}
+		return nil
+	case nil:
+		b.Valid = false
+		return nil
+	default:
+		b.Valid = false
+		return makeTypeError("set", value, "int64", "nil")
+	}
+}
+
+// String returns a string representation of b. If b is valid,
+// it formats the underlying value of b as an integer count of the largest
+// unit which represents it exactly, such as 10MiB, 1500B or 2kB,
+// otherwise it returns InvalidNullableString.
+func (b ByteSize) String() string {
+	if b.Valid {
+		return formatByteSize(b.Bytes)
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals b to a byte string representation. If b is valid,
+// it formats the underlying value of b like String does, otherwise it
+// returns nil. err is always nil.
+func (b ByteSize) MarshalText() (data []byte, err error) {
+	if b.Valid {
+		return []byte(formatByteSize(b.Bytes)), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of b to a JSON number holding
+// the count of bytes if b is valid, otherwise it returns the JSON null value.
+// err is always nil.
+func (b ByteSize) MarshalJSON() (data []byte, err error) {
+	if b.Valid {
+		return []byte(strconv.FormatInt(b.Bytes, 10)), nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying count of bytes of b as an int64 if b is
+// valid, otherwise nil. err is always nil.
+func (b ByteSize) Value() (v driver.Value, err error) {
+	if b.Valid {
+		return b.Bytes, nil
+	}
+	return nil, nil
+}
+
+// Set invalidates b if str is the empty string, otherwise it parses str into
+// the underlying value of b, and b becomes valid. Fractional numbers are
+// accepted as long as they represent a whole count of bytes, such as 1.5KiB.
+// If str cannot be parsed, or it represents a negative or fractional count of
+// bytes, or a count too large to be stored in an int64, b becomes invalid
+// and a ParseError is returned.
+func (b *ByteSize) Set(str string) error {
+	if str == "" {
+		b.Valid = false
+		return nil
+	}
+
+	b.Bytes, b.Valid = parseByteSize(str)
+	if !b.Valid {
+		return makeParseError("parse", str, *b)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to b.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (b *ByteSize) UnmarshalText(text []byte) error {
+	if b.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *b)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to b.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, b becomes invalid. If the encoded JSON data
+// represent a JSON string that Set accepts, b becomes valid, and the
+// underlying value of b is set to the parsed size, otherwise a ParseError is
+// returned. If the encoded JSON data represent a JSON number, and it is a
+// non-negative integer that fits in an int64, b becomes valid, and the
+// underlying value of b is set to the JSON number as a count of bytes,
+// otherwise a ConversionError is returned. Other JSON types produce a
+// TypeError. Malformed JSON produces an UnmarshalError.
+func (b *ByteSize) UnmarshalJSON(data []byte) error {
+	obj, ok := decodeJSONNumber(data)
+	if !ok {
+		b.Valid = false
+		return makeUnmarshalError("json", data, *b)
+	}
+	switch value := obj.(type) {
+	case string:
+		if b.Set(value) != nil || value == "" {
+			b.Valid = false
+			return makeParseError("parse", value, *b)
+		}
+		return nil
+	case json.Number:
+		n, err := value.Int64()
+		if errors.Is(err, strconv.ErrRange) {
+			err = makeConversionError("json", value, *b)
+		} else if err != nil {
+			// accept integral numbers in other notations, such as 1e3
+			f, _ := value.Float64()
+			n = int64(f)
+			err = nil
+			if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+				err = makeConversionError("json", value, *b)
+			}
+		}
+		if err == nil && n < 0 {
+			err = makeConversionError("json", value, *b)
+		}
+		b.Bytes = n
+		b.Valid = err == nil
+		return err
+	case nil:
+		b.Valid = false
+		return nil
+	default:
+		b.Valid = false
+		return makeTypeError("json", value, "string", "json.Number", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is int64,
+// and it is not negative, b becomes valid, and the underlying value of b
+// becomes obj bytes, otherwise b becomes invalid, and a ConversionError is
+// returned. If obj's type is string or []byte, it is parsed like Set does,
+// except that the empty string produces a ParseError. If obj is nil,
+// b becomes invalid. If obj's type is any other type, b becomes invalid,
+// and a TypeError is returned.
+func (b *ByteSize) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case int64:
+		b.Bytes = value
+		b.Valid = value >= 0
+		if !b.Valid {
+			return makeConversionError("sql", value, *b)
+		}
+		return nil
+	case string:
+		return b.scanString(value)
+	case []byte:
+		return b.scanString(string(value))
+	case nil:
+		b.Valid = false
+		return nil
+	default:
+		b.Valid = false
+		return makeTypeError("sql", value, "int64", "string", "[]byte", "nil")
+	}
+}
+
+// scanString parses a size returned by a database driver as a string.
+// Unlike Set, the empty string is not accepted.
+func (b *ByteSize) scanString(str string) error {
+	b.Bytes, b.Valid = parseByteSize(str)
+	if !b.Valid {
+		return makeParseError("sql", str, *b)
+	}
+	return nil
+}
+
+// formatByteSize formats n as an integer count of the largest unit in
+// byteSizeUnits which divides n exactly.
+func formatByteSize(n int64) string {
+	for _, u := range byteSizeUnits {
+		if n != 0 && n%u.size == 0 {
+			return strconv.FormatInt(n/u.size, 10) + u.name
+		}
+	}
+	return strconv.FormatInt(n, 10) + "B"
+}
+
+// parseByteSize parses str, a non-negative decimal number followed by an
+// optional unit in byteSizeUnits, possibly separated by a space, into a
+// count of bytes. ok is false if str cannot be parsed, or it does not
+// represent a whole count of bytes which fits in an int64.
+func parseByteSize(str string) (n int64, ok bool) {
+	i := strings.IndexFunc(str, func(r rune) bool {
+		return r != '.' && (r < '0' || r > '9')
+	})
+	if i < 0 {
+		i = len(str)
+	}
+	num, unit := str[:i], strings.TrimPrefix(str[i:], " ")
+	if num == "" || unit == "" && i < len(str) ||
+		num[0] == '.' || num[len(num)-1] == '.' || strings.Count(num, ".") > 1 {
+		return 0, false
+	}
+
+	size := int64(0)
+	if unit == "" {
+		size = 1
+	}
+	for _, u := range byteSizeUnits {
+		if strings.EqualFold(unit, u.name) {
+			size = u.size
+		}
+	}
+	if size == 0 {
+		return 0, false
+	}
+
+	r, ok := new(big.Rat).SetString(num)
+	if !ok {
+		return 0, false
+	}
+	r.Mul(r, new(big.Rat).SetInt64(size))
+	if !r.IsInt() || !r.Num().IsInt64() {
+		return 0, false
+	}
+	return r.Num().Int64(), true
+}
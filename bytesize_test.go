@@ -0,0 +1,319 @@
+package null_test
+
+import (
+	"math"
+	"null"
+	"reflect"
+	"testing"
+)
+
+func TestByteSize_From(t *testing.T) {
+	neg := int64(-1024)
+	cases := []struct {
+		nullable null.ByteSize
+		valid    bool
+	}{
+		{null.ByteSizeFrom(1024), true},
+		{null.ByteSizeFrom(0), true},
+		{null.ByteSizeFrom(neg), false},
+		{null.ByteSizeFromPtr(&neg), false},
+		{null.ByteSizeFromZero(0), false},
+		{null.ByteSizeFromZero(neg), false},
+	}
+
+	for n, c := range cases {
+		if c.valid != c.nullable.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, c.nullable.Valid,
+			)
+		}
+	}
+
+	b := null.ByteSizeFrom(1)
+	err := b.SetInterface(neg)
+	if reflect.TypeOf(err) != reflect.TypeOf(null.ConversionError{}) {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+	if b.Valid {
+		t.Fatalf("%s: size should be invalid", t.Name())
+	}
+}
+
+func TestByteSize_String(t *testing.T) {
+	cases := []struct {
+		nullable null.ByteSize
+		string   string
+	}{
+		{null.ByteSizeFrom(0), "0B"},
+		{null.ByteSizeFrom(1), "1B"},
+		{null.ByteSizeFrom(1536), "1536B"},
+		{null.ByteSizeFrom(2000), "2kB"},
+		{null.ByteSizeFrom(1024), "1KiB"},
+		{null.ByteSizeFrom(10 << 20), "10MiB"},
+		{null.ByteSizeFrom(1e9), "1GB"},
+		{null.ByteSizeFrom(1 << 60), "1EiB"},
+		{null.ByteSizeFrom(math.MaxInt64), "9223372036854775807B"},
+		{null.ByteSizeFrom(-2048), "<invalid>"},
+		{null.ByteSize{}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestByteSize_MarshalText(t *testing.T) {
+	cases := []struct {
+		nullable null.ByteSize
+		bytes    []byte
+	}{
+		{null.ByteSizeFrom(3 << 30), []byte("3GiB")},
+		{null.ByteSize{}, nil},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalText()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.bytes, b) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(b),
+			)
+		}
+	}
+}
+
+func TestByteSize_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		nullable null.ByteSize
+		json     []byte
+	}{
+		{null.ByteSizeFrom(10 << 20), []byte("10485760")},
+		{null.ByteSizeFrom(0), []byte("0")},
+		{null.ByteSize{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestByteSize_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.ByteSize
+		value    interface{}
+	}{
+		{null.ByteSizeFrom(1 << 20), int64(1 << 20)},
+		{null.ByteSize{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestByteSize_Set(t *testing.T) {
+	var b null.ByteSize
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		valid   bool
+		value   int64
+		errType reflect.Type
+	}{
+		{"512", true, 512, nilType},
+		{"512B", true, 512, nilType},
+		{"10MiB", true, 10 << 20, nilType},
+		{"10 MiB", true, 10 << 20, nilType},
+		{"10mib", true, 10 << 20, nilType},
+		{"1kB", true, 1000, nilType},
+		{"1KB", true, 1000, nilType},
+		{"1KiB", true, 1024, nilType},
+		{"1.5GB", true, 1.5e9, nilType},
+		{"0.5KiB", true, 512, nilType},
+		{"8EiB", false, 0, parseErrType},
+		{"7EiB", true, 7 << 60, nilType},
+		{"", false, 0, nilType},
+		{"0.5B", false, 0, parseErrType},
+		{"-1MiB", false, 0, parseErrType},
+		{"1.MiB", false, 0, parseErrType},
+		{".5MiB", false, 0, parseErrType},
+		{"1.2.3MiB", false, 0, parseErrType},
+		{"MiB", false, 0, parseErrType},
+		{"10 ", false, 0, parseErrType},
+		{"10  MiB", false, 0, parseErrType},
+		{"10M", false, 0, parseErrType},
+		{"10Mb/s", false, 0, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := b.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != b.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, b.Valid,
+			)
+		}
+		if b.Valid && c.value != b.Bytes {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.value, b.Bytes,
+			)
+		}
+	}
+}
+
+func TestByteSize_UnmarshalText(t *testing.T) {
+	var b null.ByteSize
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("64KiB"), true, nilType},
+		{nil, false, nilType},
+		{[]byte("64 kibibytes"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := b.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != b.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, b.Valid,
+			)
+		}
+	}
+}
+
+func TestByteSize_UnmarshalJSON(t *testing.T) {
+	var b null.ByteSize
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	convErrType := reflect.TypeOf(null.ConversionError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		value   int64
+		errType reflect.Type
+	}{
+		{[]byte("1048576"), true, 1 << 20, nilType},
+		{[]byte("1e3"), true, 1000, nilType},
+		{[]byte(`"1MiB"`), true, 1 << 20, nilType},
+		{[]byte("null"), false, 0, nilType},
+		{[]byte(`""`), false, 0, parseErrType},
+		{[]byte(`"1 megabyte"`), false, 0, parseErrType},
+		{[]byte("-1"), false, 0, convErrType},
+		{[]byte("1.5"), false, 0, convErrType},
+		{[]byte("9223372036854775808"), false, 0, convErrType},
+		{[]byte("true"), false, 0, typeErrType},
+		{[]byte("1MiB"), false, 0, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := b.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != b.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, b.Valid,
+			)
+		}
+		if b.Valid && c.value != b.Bytes {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.value, b.Bytes,
+			)
+		}
+	}
+}
+
+func TestByteSize_Scan(t *testing.T) {
+	var b null.ByteSize
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	convErrType := reflect.TypeOf(null.ConversionError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{int64(4096), true, nilType},
+		{"4KiB", true, nilType},
+		{[]byte("4096"), true, nilType},
+		{nil, false, nilType},
+		{int64(-1), false, convErrType},
+		{"", false, parseErrType},
+		{"4 KiBs", false, parseErrType},
+		{4096.0, false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := b.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != b.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, b.Valid,
+			)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Date implements a nullable civil date, that is, a date without a time of
+// day and without a time zone. It is meant to be used with SQL DATE columns.
+type Date struct {
+	// Year holds the year of the underlying date.
+	Year int
+
+	// Month holds the month of the underlying date.
+	Month time.Month
+
+	// Day holds the day of the month of the underlying date.
+	Day int
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// DateFrom creates a valid Date from the date of v, as seen in v's location.
+func DateFrom(v time.Time) Date {
+	return DateFromPtr(&v)
+}
+
+// DateFromPtr creates a Date from the date of the time.Time pointed to by p,
+// as seen in its location. If p is nil, the returned Date is invalid.
+func DateFromPtr(p *time.Time) Date {
+	if p != nil {
+		year, month, day := p.Date()
+		return Date{
+			Year:  year,
+			Month: month,
+			Day:   day,
+			Valid: true,
+		}
+	}
+	return Date{}
+}
+
+// DateFromZero creates a Date from the date of v, as seen in v's location.
+// If v represents the zero time instant, the returned Date is invalid.
+func DateFromZero(v time.Time) Date {
+	d := DateFrom(v)
+	d.Valid = !v.IsZero()
+	return d
+}
+
+// Ptr returns a pointer to a time.Time representing midnight UTC of the
+// underlying date of d if d is valid, otherwise returns nil.
+func (d Date) Ptr() *time.Time {
+	if d.Valid {
+		t := d.time()
+		return &t
+	}
+	return nil
+}
+
+// Zero returns a time.Time representing midnight UTC of the underlying date
+// of d if d is valid, otherwise returns a time.Time representing the zero
+// time instant.
+func (d Date) Zero() time.Time {
+	if d.Valid {
+		return d.time()
+	}
+	return time.Time{}
+}
+
+// From sets the underlying date of d to the date of v, as seen in v's
+// location. d becomes valid.
+func (d *Date) From(v time.Time) {
+	*d = DateFrom(v)
+}
+
+// FromPtr invalidates d if p is nil, otherwise it sets the underlying date
+// of d to the date of the time.Time pointed to by p, and d becomes valid.
+func (d *Date) FromPtr(p *time.Time) {
+	d.Valid = p != nil
+	if p != nil {
+		d.From(*p)
+	}
+}
+
+// FromZero invalidates d if v represents the zero time instant,
+// otherwise it sets the underlying date of d to the date of v,
+// and d becomes valid.
+func (d *Date) FromZero(v time.Time) {
+	*d = DateFromZero(v)
+}
+
+// IsValid returns true if d is valid.
+func (d Date) IsValid() bool {
+	return d.Valid
+}
+
+// Invalidate makes d invalid.
+func (d *Date) Invalidate() {
+	d.Valid = false
+}
+
+// Interface returns a time.Time representing midnight UTC of the underlying
+// date of d if d is valid, otherwise nil.
+func (d Date) Interface() interface{} {
+	if d.Valid {
+		return d.time()
+	}
+	return nil
+}
+
+// SetInterface invalidates d if v is nil, otherwise if v's type is
+// time.Time, it sets the underlying date of d to the date of v,
+// and d becomes valid. If v's type is any other type, d becomes invalid,
+// and a TypeError is returned.
+func (d *Date) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case time.Time:
+		d.From(value)
+		return nil
+	case nil:
+		d.Valid = false
+		return nil
+	default:
+		d.Valid = false
+		return makeTypeError("set", value, "time.Time", "nil")
+	}
+}
+
+// AddDays returns d with n days added to its underlying date. n may be
+// negative. If d is not valid, the returned Date is not valid either.
+func (d Date) AddDays(n int) Date {
+	if !d.Valid {
+		return Date{}
+	}
+	return DateFrom(time.Date(d.Year, d.Month, d.Day+n, 0, 0, 0, 0, time.UTC))
+}
+
+// Before returns true if both d and e are valid, and the underlying date of
+// d is before the underlying date of e.
+func (d Date) Before(e Date) bool {
+	return d.Valid && e.Valid && d.time().Before(e.time())
+}
+
+// After returns true if both d and e are valid, and the underlying date of
+// d is after the underlying date of e.
+func (d Date) After(e Date) bool {
+	return d.Valid && e.Valid && d.time().After(e.time())
+}
+
+// Equal returns true if both d and e are valid and their underlying dates
+// are the same, or if neither d nor e is valid.
+func (d Date) Equal(e Date) bool {
+	if d.Valid && e.Valid {
+		return d.time().Equal(e.time())
+	}
+	return d.Valid == e.Valid
+}
+
+// String returns a string representation of d. If d is valid,
+// it formats the underlying date of d as YYYY-MM-DD, otherwise it returns
+// InvalidNullableString. Years before 0 or after 9999 are formatted with a
+// sign and at least four digits, like ISO 8601 expanded years, such as
+// +10000-01-01 or -0001-12-31.
+func (d Date) String() string {
+	if !d.Valid {
+		return InvalidNullableString
+	}
+	if d.Year < 0 || d.Year > 9999 {
+		return fmt.Sprintf("%+05d-%02d-%02d", d.Year, d.Month, d.Day)
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// MarshalText marshals d to a byte string representation. If d is valid, it
+// formats the underlying date of d as YYYY-MM-DD, otherwise it returns nil.
+// err is always nil.
+func (d Date) MarshalText() (data []byte, err error) {
+	if d.Valid {
+		return []byte(d.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying date of d to a JSON string formatted as
+// YYYY-MM-DD if d is valid, otherwise it returns the JSON null value.
+// err is always nil.
+func (d Date) MarshalJSON() (data []byte, err error) {
+	if d.Valid {
+		return []byte(`"` + d.String() + `"`), nil
+	}
+	return jNull, nil
+}
+
+// Value returns a time.Time representing midnight UTC of the underlying date
+// of d if d is valid, otherwise nil. err is always nil.
+func (d Date) Value() (v driver.Value, err error) {
+	if d.Valid {
+		return d.time(), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates d if str is the empty string, otherwise it parses str into
+// the underlying date of d, and d becomes valid. str is formatted like
+// String does, so that signed years are accepted as well. If str is not a
+// valid date formatted as YYYY-MM-DD, d becomes invalid and a ParseError is
+// returned.
+func (d *Date) Set(str string) error {
+	var ok bool
+	*d, ok = parseDate(str)
+	d.Valid = ok && str != ""
+	if str == "" || ok {
+		return nil
+	}
+
+	return makeParseError("parse", str, *d)
+}
+
+// UnmarshalText unmarshals from a byte string to d.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (d *Date) UnmarshalText(text []byte) error {
+	if d.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *d)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to d.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, d becomes invalid. If the encoded JSON data
+// represent a JSON string formatted as YYYY-MM-DD, d becomes valid,
+// and the underlying date of d is set to the JSON string. If the encoded
+// JSON data represent a JSON string, but not formatted as YYYY-MM-DD,
+// a ParseError is returned. Other JSON types produce a TypeError.
+// Malformed JSON produces an UnmarshalError.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var obj interface{}
+	if json.Unmarshal(data, &obj) != nil {
+		d.Valid = false
+		return makeUnmarshalError("json", data, *d)
+	}
+	switch value := obj.(type) {
+	case string:
+		if d.Set(value) != nil || value == "" {
+			d.Valid = false
+			return makeParseError("parse", value, *d)
+		}
+		return nil
+	case nil:
+		d.Valid = false
+		return nil
+	default:
+		d.Valid = false
+		return makeTypeError("json", value, "string", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is time.Time,
+// d becomes valid, and the underlying date of d becomes the date of obj,
+// as seen in obj's location. If obj's type is string or []byte, and it is
+// formatted as YYYY-MM-DD, d becomes valid, and the underlying date of d is
+// set accordingly, otherwise d becomes invalid, and a ParseError is
+// returned. If obj is nil, d becomes invalid. If obj's type is any other
+// type, d becomes invalid, and a TypeError is returned.
+func (d *Date) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case time.Time:
+		d.From(value)
+		return nil
+	case string:
+		return d.scanString(value)
+	case []byte:
+		return d.scanString(string(value))
+	case nil:
+		d.Valid = false
+		return nil
+	default:
+		d.Valid = false
+		return makeTypeError("sql", value, "time.Time", "string", "[]byte",
+			"nil")
+	}
+}
+
+// scanString parses a date returned by a database driver as a string.
+// Unlike Set, the empty string is not accepted.
+func (d *Date) scanString(str string) error {
+	if d.Set(str) != nil || str == "" {
+		d.Valid = false
+		return makeParseError("sql", str, *d)
+	}
+	return nil
+}
+
+// time returns a time.Time representing midnight UTC of the underlying date
+// of d, regardless of its validity.
+func (d Date) time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// parseDate parses str, formatted as YYYY-MM-DD, or as [+-]YYYY-MM-DD with
+// at least four digits of year, into a valid Date. ok is false if str is not
+// well formed, or it does not represent an existing date.
+func parseDate(str string) (d Date, ok bool) {
+	n := len(str)
+	if n < 10 || str[n-6] != '-' || str[n-3] != '-' {
+		return Date{}, false
+	}
+	year, month, day := str[:n-6], str[n-5:n-3], str[n-2:]
+	digits := year
+	if year[0] == '+' || year[0] == '-' {
+		digits = year[1:]
+	} else if len(year) != 4 {
+		return Date{}, false
+	}
+	if len(digits) < 4 || !isDigits(digits) || !isDigits(month) ||
+		!isDigits(day) {
+		return Date{}, false
+	}
+
+	y, err := strconv.Atoi(year)
+	if err != nil {
+		return Date{}, false
+	}
+	m, _ := strconv.Atoi(month)
+	dd, _ := strconv.Atoi(day)
+	d = DateFrom(time.Date(y, time.Month(m), dd, 0, 0, 0, 0, time.UTC))
+	if d.Year != y || int(d.Month) != m || d.Day != dd {
+		return Date{}, false
+	}
+	return d, true
+}
+
+// isDigits returns true if str only holds ASCII decimal digits.
+func isDigits(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
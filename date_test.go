@@ -0,0 +1,441 @@
+package null_test
+
+import (
+	"null"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDateFrom(t *testing.T) {
+	cet := time.FixedZone("CET", 3600)
+
+	cases := []struct {
+		time  time.Time
+		date  null.Date
+		valid bool
+	}{
+		{
+			time.Date(1990, 5, 1, 0, 0, 0, 0, time.UTC),
+			null.Date{Year: 1990, Month: 5, Day: 1, Valid: true}, true,
+		},
+		{
+			time.Date(1990, 5, 1, 0, 30, 0, 0, cet),
+			null.Date{Year: 1990, Month: 5, Day: 1, Valid: true}, true,
+		},
+		{
+			time.Time{},
+			null.Date{Year: 1, Month: 1, Day: 1, Valid: true}, true,
+		},
+	}
+
+	for n, c := range cases {
+		d := null.DateFrom(c.time)
+		if c.date != d {
+			t.Fatalf(
+				"%s, case #%d: date mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.date, d,
+			)
+		}
+	}
+}
+
+func TestDateFromPtr(t *testing.T) {
+	ts := time.Date(1990, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	if d := null.DateFromPtr(&ts); !d.Valid || d.Day != 1 {
+		t.Fatalf("%s: unexpected date %v", t.Name(), d)
+	}
+	if d := null.DateFromPtr(nil); d.Valid {
+		t.Fatalf("%s: nil pointer produced a valid date", t.Name())
+	}
+}
+
+func TestDateFromZero(t *testing.T) {
+	cases := []struct {
+		time  time.Time
+		valid bool
+	}{
+		{time.Time{}, false},
+		{time.Date(1990, 5, 1, 0, 0, 0, 0, time.UTC), true},
+	}
+
+	for n, c := range cases {
+		d := null.DateFromZero(c.time)
+		if c.valid != d.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, d.Valid,
+			)
+		}
+	}
+}
+
+func TestDate_Zero(t *testing.T) {
+	cases := []struct {
+		nullable null.Date
+		time     time.Time
+	}{
+		{
+			null.Date{Year: 1990, Month: 5, Day: 1, Valid: true},
+			time.Date(1990, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{null.Date{}, time.Time{}},
+		{null.Date{Year: 1990, Month: 5, Day: 1}, time.Time{}},
+	}
+
+	for n, c := range cases {
+		if !c.time.Equal(c.nullable.Zero()) {
+			t.Fatalf(
+				"%s, case #%d: time mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.time, c.nullable.Zero(),
+			)
+		}
+		p := c.nullable.Ptr()
+		if c.nullable.Valid != (p != nil) {
+			t.Fatalf("%s, case #%d: wrong pointer returned", t.Name(), n+1)
+		}
+	}
+}
+
+func TestDate_AddDays(t *testing.T) {
+	cases := []struct {
+		nullable null.Date
+		days     int
+		date     null.Date
+	}{
+		{
+			null.Date{Year: 2020, Month: 2, Day: 28, Valid: true}, 1,
+			null.Date{Year: 2020, Month: 2, Day: 29, Valid: true},
+		},
+		{
+			null.Date{Year: 2019, Month: 2, Day: 28, Valid: true}, 1,
+			null.Date{Year: 2019, Month: 3, Day: 1, Valid: true},
+		},
+		{
+			null.Date{Year: 2019, Month: 1, Day: 1, Valid: true}, -1,
+			null.Date{Year: 2018, Month: 12, Day: 31, Valid: true},
+		},
+		{null.Date{Year: 2019, Month: 1, Day: 1}, 1, null.Date{}},
+	}
+
+	for n, c := range cases {
+		d := c.nullable.AddDays(c.days)
+		if c.date != d {
+			t.Fatalf(
+				"%s, case #%d: date mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.date, d,
+			)
+		}
+	}
+}
+
+func TestDate_Compare(t *testing.T) {
+	d1 := null.Date{Year: 2019, Month: 1, Day: 1, Valid: true}
+	d2 := null.Date{Year: 2019, Month: 1, Day: 2, Valid: true}
+	inv := null.Date{}
+
+	cases := []struct {
+		a, b                 null.Date
+		before, after, equal bool
+	}{
+		{d1, d2, true, false, false},
+		{d2, d1, false, true, false},
+		{d1, d1, false, false, true},
+		{d1, inv, false, false, false},
+		{inv, d1, false, false, false},
+		{inv, inv, false, false, true},
+	}
+
+	for n, c := range cases {
+		if c.before != c.a.Before(c.b) {
+			t.Fatalf("%s, case #%d: Before mismatch", t.Name(), n+1)
+		}
+		if c.after != c.a.After(c.b) {
+			t.Fatalf("%s, case #%d: After mismatch", t.Name(), n+1)
+		}
+		if c.equal != c.a.Equal(c.b) {
+			t.Fatalf("%s, case #%d: Equal mismatch", t.Name(), n+1)
+		}
+	}
+}
+
+func TestDate_String(t *testing.T) {
+	cases := []struct {
+		nullable null.Date
+		string   string
+	}{
+		{null.Date{Year: 1990, Month: 5, Day: 1, Valid: true}, "1990-05-01"},
+		{null.Date{Year: 12, Month: 12, Day: 31, Valid: true}, "0012-12-31"},
+		{null.Date{Year: 0, Month: 1, Day: 1, Valid: true}, "0000-01-01"},
+		{null.Date{Year: 10000, Month: 1, Day: 1, Valid: true}, "+10000-01-01"},
+		{null.Date{Year: -1, Month: 12, Day: 31, Valid: true}, "-0001-12-31"},
+		{null.Date{}, "<invalid>"},
+		{null.Date{Year: 1990, Month: 5, Day: 1}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+
+		var d null.Date
+		if c.nullable.Valid && (d.Set(c.string) != nil || d != c.nullable) {
+			t.Fatalf(
+				"%s, case #%d: round trip mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.nullable, d,
+			)
+		}
+	}
+}
+
+func TestDate_MarshalText(t *testing.T) {
+	cases := []struct {
+		nullable null.Date
+		bytes    []byte
+	}{
+		{
+			null.Date{Year: 1990, Month: 5, Day: 1, Valid: true},
+			[]byte("1990-05-01"),
+		},
+		{null.Date{}, nil},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalText()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.bytes, b) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(b),
+			)
+		}
+	}
+}
+
+func TestDate_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		nullable null.Date
+		json     []byte
+	}{
+		{
+			null.Date{Year: 1990, Month: 5, Day: 1, Valid: true},
+			[]byte(`"1990-05-01"`),
+		},
+		{null.Date{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestDate_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.Date
+		value    interface{}
+	}{
+		{
+			null.Date{Year: 1990, Month: 5, Day: 1, Valid: true},
+			time.Date(1990, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{null.Date{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestDate_Set(t *testing.T) {
+	var d null.Date
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		date    null.Date
+		errType reflect.Type
+	}{
+		{
+			"1990-05-01",
+			null.Date{Year: 1990, Month: 5, Day: 1, Valid: true}, nilType,
+		},
+		{
+			"+12345-06-07",
+			null.Date{Year: 12345, Month: 6, Day: 7, Valid: true}, nilType,
+		},
+		{
+			"-0044-03-15",
+			null.Date{Year: -44, Month: 3, Day: 15, Valid: true}, nilType,
+		},
+		{"", null.Date{}, nilType},
+		{"1990-02-30", null.Date{}, parseErrType},
+		{"12345-06-07", null.Date{}, parseErrType},
+		{"+123-06-07", null.Date{}, parseErrType},
+		{"1990-5-01", null.Date{}, parseErrType},
+		{"+99999999999999999999-01-01", null.Date{}, parseErrType},
+		{"1990-05-01T00:00:00Z", null.Date{}, parseErrType},
+		{"x", null.Date{}, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := d.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.date.Valid != d.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.date.Valid, d.Valid,
+			)
+		}
+		if d.Valid && c.date != d {
+			t.Fatalf(
+				"%s, case #%d: date mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.date, d,
+			)
+		}
+	}
+}
+
+func TestDate_UnmarshalText(t *testing.T) {
+	var d null.Date
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("1990-05-01"), true, nilType},
+		{nil, false, nilType},
+		{[]byte(""), false, nilType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := d.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != d.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, d.Valid,
+			)
+		}
+	}
+}
+
+func TestDate_UnmarshalJSON(t *testing.T) {
+	var d null.Date
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`"1990-05-01"`), true, nilType},
+		{[]byte("null"), false, nilType},
+		{[]byte(`""`), false, parseErrType},
+		{[]byte(`"1990-05-01T00:00:00Z"`), false, parseErrType},
+		{[]byte("1"), false, typeErrType},
+		{nil, false, unmarshalErrType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := d.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != d.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, d.Valid,
+			)
+		}
+	}
+}
+
+func TestDate_Scan(t *testing.T) {
+	var d null.Date
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	exp := null.Date{Year: 1990, Month: 5, Day: 1, Valid: true}
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{time.Date(1990, 5, 1, 0, 0, 0, 0, time.UTC), true, nilType},
+		{"1990-05-01", true, nilType},
+		{[]byte("1990-05-01"), true, nilType},
+		{nil, false, nilType},
+		{"", false, parseErrType},
+		{[]byte("x"), false, parseErrType},
+		{int64(1), false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := d.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != d.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, d.Valid,
+			)
+		}
+		if d.Valid && exp != d {
+			t.Fatalf(
+				"%s, case #%d: date mismatch (expected %v, got %v)",
+				t.Name(), n+1, exp, d,
+			)
+		}
+	}
+}
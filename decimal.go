@@ -0,0 +1,507 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DecimalFormat selects the JSON representation of Decimal.
+type DecimalFormat int
+
+const (
+	// DecimalNumberFormat encodes Decimal as a JSON number.
+	DecimalNumberFormat DecimalFormat = iota
+
+	// DecimalStringFormat encodes Decimal as a JSON string, for consumers
+	// which would otherwise decode it into a float64.
+	DecimalStringFormat
+)
+
+const (
+	// maxDecimalScale bounds the scale of parsed decimals, and the exponent
+	// of parsed scientific notations, so that malicious input cannot make
+	// the unscaled value grow without limit. It matches the maximum number
+	// of fractional digits allowed by PostgreSQL NUMERIC columns.
+	maxDecimalScale = 16383
+
+	// maxDecimalDigits bounds the count of digits of parsed decimals, for
+	// the same reason. It matches the maximum number of digits allowed by
+	// PostgreSQL NUMERIC columns, before and after the decimal point.
+	maxDecimalDigits = 131072 + maxDecimalScale
+)
+
+// Decimal implements a nullable exact decimal number, meant to be used with
+// SQL NUMERIC and DECIMAL columns. The value of the decimal is
+// Unscaled * 10^-Scale.
+type Decimal struct {
+	// Unscaled holds the unscaled value of the underlying decimal. A nil
+	// Unscaled is equivalent to zero. Methods of Decimal never modify it.
+	Unscaled *big.Int
+
+	// Scale holds the count of fractional digits of the underlying decimal.
+	// It is never negative.
+	Scale int
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+
+	// Format holds the format used by the MarshalJSON method. It defaults to
+	// DecimalNumberFormat, and it is preserved by all the methods which
+	// modify d, and passed on to the results of arithmetic methods.
+	// UnmarshalJSON accepts both formats regardless.
+	Format DecimalFormat
+}
+
+// DecimalFrom creates a valid Decimal whose value is unscaled * 10^-scale.
+// A negative scale is treated as 0.
+func DecimalFrom(unscaled int64, scale int) Decimal {
+	return DecimalFromBig(big.NewInt(unscaled), scale)
+}
+
+// DecimalFromBig creates a Decimal whose value is unscaled * 10^-scale.
+// A negative scale is treated as 0. If unscaled is nil, the returned Decimal
+// is invalid. unscaled is copied.
+func DecimalFromBig(unscaled *big.Int, scale int) Decimal {
+	if unscaled == nil {
+		return Decimal{}
+	}
+	if scale < 0 {
+		scale = 0
+	}
+	return Decimal{
+		Unscaled: new(big.Int).Set(unscaled),
+		Scale:    scale,
+		Valid:    true,
+	}
+}
+
+// DecimalFromFloat creates a Decimal from the shortest decimal
+// representation of v that rounds back to v, so that 0.1 becomes exactly
+// 0.1. If v is NaN or infinite, the returned Decimal is invalid.
+func DecimalFromFloat(v float64) Decimal {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return Decimal{}
+	}
+	d, _ := parseDecimal(strconv.FormatFloat(v, 'f', -1, 64))
+	return d
+}
+
+// DecimalFromZero creates a Decimal whose value is unscaled * 10^-scale,
+// like DecimalFromBig does. If unscaled is nil or 0, the returned Decimal is
+// invalid.
+func DecimalFromZero(unscaled *big.Int, scale int) Decimal {
+	d := DecimalFromBig(unscaled, scale)
+	d.Valid = unscaled != nil && unscaled.Sign() != 0
+	return d
+}
+
+// Ptr returns the underlying value of d as a big.Rat if d is valid,
+// otherwise returns nil. It behaves like Rat.
+func (d Decimal) Ptr() *big.Rat {
+	return d.Rat()
+}
+
+// Zero returns the underlying value of d as a big.Rat if d is valid,
+// otherwise returns 0.
+func (d Decimal) Zero() *big.Rat {
+	if d.Valid {
+		return d.Rat()
+	}
+	return new(big.Rat)
+}
+
+// From sets the underlying value of d to unscaled * 10^-scale, where
+// unscaled is copied, and d becomes valid if unscaled is not nil, otherwise
+// d becomes invalid. A negative scale is treated as 0.
+func (d *Decimal) From(unscaled *big.Int, scale int) {
+	d.assign(DecimalFromBig(unscaled, scale))
+}
+
+// FromZero invalidates d if unscaled is nil or 0, otherwise it sets the
+// underlying value of d to unscaled * 10^-scale, where unscaled is copied,
+// and d becomes valid. A negative scale is treated as 0.
+func (d *Decimal) FromZero(unscaled *big.Int, scale int) {
+	d.assign(DecimalFromZero(unscaled, scale))
+}
+
+// IsValid returns true if d is valid.
+func (d Decimal) IsValid() bool {
+	return d.Valid
+}
+
+// Invalidate makes d invalid.
+func (d *Decimal) Invalidate() {
+	d.Valid = false
+}
+
+// Interface returns the exact string representation of the underlying value
+// of d if d is valid, otherwise nil.
+func (d Decimal) Interface() interface{} {
+	if d.Valid {
+		return d.String()
+	}
+	return nil
+}
+
+// SetInterface invalidates d if v is nil, otherwise if v's type is string,
+// it behaves like Set, except that the empty string produces a ParseError.
+// If v's type is any other type, d becomes invalid,
+// and a TypeError is returned.
+func (d *Decimal) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case string:
+		if d.Set(value) != nil || value == "" {
+			d.Valid = false
+			return makeParseError("set", value, *d)
+		}
+		return nil
+	case nil:
+		d.Valid = false
+		return nil
+	default:
+		d.Valid = false
+		return makeTypeError("set", value, "string", "nil")
+	}
+}
+
+// Rat returns the underlying value of d as a big.Rat if d is valid,
+// otherwise returns nil.
+func (d Decimal) Rat() *big.Rat {
+	if !d.Valid {
+		return nil
+	}
+	return new(big.Rat).SetFrac(d.unscaled(), pow10(d.Scale))
+}
+
+// Add returns the sum of d and e. If either d or e is invalid,
+// the returned Decimal is invalid. The scale of the sum is the greater of
+// the scales of d and e.
+func (d Decimal) Add(e Decimal) Decimal {
+	if !d.Valid || !e.Valid {
+		return d.with(nil, 0)
+	}
+	x, y, scale := alignDecimals(d, e)
+	return d.with(x.Add(x, y), scale)
+}
+
+// Sub returns the difference of d and e. If either d or e is invalid,
+// the returned Decimal is invalid. The scale of the difference is the
+// greater of the scales of d and e.
+func (d Decimal) Sub(e Decimal) Decimal {
+	if !d.Valid || !e.Valid {
+		return d.with(nil, 0)
+	}
+	x, y, scale := alignDecimals(d, e)
+	return d.with(x.Sub(x, y), scale)
+}
+
+// Mul returns the product of d and e. If either d or e is invalid,
+// the returned Decimal is invalid. The scale of the product is the sum of
+// the scales of d and e, unless it exceeds the scale allowed by Set, in
+// which case the product is rounded like Round does.
+func (d Decimal) Mul(e Decimal) Decimal {
+	if !d.Valid || !e.Valid {
+		return d.with(nil, 0)
+	}
+	p := d.with(new(big.Int).Mul(d.unscaled(), e.unscaled()), d.Scale+e.Scale)
+	return p.Round(maxDecimalScale)
+}
+
+// Round returns d rounded to places fractional digits, where halves are
+// rounded away from zero. A negative places is treated as 0. If the scale
+// of d is not greater than places, d is returned unchanged. If d is invalid,
+// the returned Decimal is invalid.
+func (d Decimal) Round(places int) Decimal {
+	if !d.Valid {
+		return d.with(nil, 0)
+	}
+	if places < 0 {
+		places = 0
+	}
+	if d.Scale <= places {
+		return d
+	}
+
+	div := pow10(d.Scale - places)
+	q, r := new(big.Int).QuoRem(d.unscaled(), div, new(big.Int))
+	if r.Abs(r).Lsh(r, 1).Cmp(div) >= 0 {
+		q.Add(q, big.NewInt(int64(d.unscaled().Sign())))
+	}
+	return d.with(q, places)
+}
+
+// Cmp compares d and e. It returns a valid Int holding -1 if d is less than
+// e, 0 if d equals e, and +1 if d is greater than e, regardless of their
+// scales. If either d or e is invalid, the returned Int is invalid,
+// like a comparison with NULL in SQL.
+func (d Decimal) Cmp(e Decimal) Int {
+	if !d.Valid || !e.Valid {
+		return Int{}
+	}
+	x, y, _ := alignDecimals(d, e)
+	return IntFrom(x.Cmp(y))
+}
+
+// String returns a string representation of d. If d is valid,
+// it returns the exact decimal representation of the underlying value of d,
+// with exactly Scale fractional digits, otherwise it returns
+// InvalidNullableString.
+func (d Decimal) String() string {
+	if !d.Valid {
+		return InvalidNullableString
+	}
+
+	digits := new(big.Int).Abs(d.unscaled()).String()
+	if d.Scale > 0 {
+		if len(digits) <= d.Scale {
+			digits = strings.Repeat("0", d.Scale-len(digits)+1) + digits
+		}
+		i := len(digits) - d.Scale
+		digits = digits[:i] + "." + digits[i:]
+	}
+	if d.unscaled().Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// MarshalText marshals d to a byte string representation. If d is valid,
+// it returns the exact decimal representation of the underlying value of d,
+// otherwise it returns nil. err is always nil.
+func (d Decimal) MarshalText() (data []byte, err error) {
+	if d.Valid {
+		return []byte(d.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the exact decimal representation of the underlying
+// value of d to a JSON number, or to a JSON string if the Format of d is
+// DecimalStringFormat, if d is valid, otherwise it returns the JSON null
+// value. err is always nil.
+func (d Decimal) MarshalJSON() (data []byte, err error) {
+	if !d.Valid {
+		return jNull, nil
+	}
+	if d.Format == DecimalStringFormat {
+		return []byte(`"` + d.String() + `"`), nil
+	}
+	return []byte(d.String()), nil
+}
+
+// Value returns the exact decimal representation of the underlying value of
+// d as a string if d is valid, otherwise nil. err is always nil.
+func (d Decimal) Value() (v driver.Value, err error) {
+	if d.Valid {
+		return d.String(), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates d if str is the empty string, otherwise it parses str into
+// the underlying value of d, and d becomes valid. str is a decimal number,
+// optionally signed, optionally in scientific notation, such as -12.50 or
+// 1.25e3. The scale of d is the count of fractional digits of str,
+// adjusted by the exponent. If str cannot be parsed, d becomes invalid and
+// a ParseError is returned.
+func (d *Decimal) Set(str string) error {
+	if str == "" {
+		d.Valid = false
+		return nil
+	}
+
+	v, ok := parseDecimal(str)
+	d.assign(v)
+	if !ok {
+		return makeParseError("parse", str, *d)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to d.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	if d.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *d)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to d. If the encoded
+// JSON data represent the JSON null value, or an error is produced, d becomes
+// invalid. If the encoded JSON data represent a JSON number, d becomes valid,
+// and the underlying value of d is set to the JSON number, without converting
+// it to float64 first. If the scale, the exponent or the count of digits of
+// the JSON number is too large, d becomes invalid, and a ConversionError is
+// returned. JSON strings holding a decimal number are accepted as well,
+// otherwise a ParseError is returned. Other JSON types produce a TypeError.
+// Malformed JSON produces an UnmarshalError.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	obj, ok := decodeJSONNumber(data)
+	if !ok {
+		d.Valid = false
+		return makeUnmarshalError("json", data, *d)
+	}
+	switch value := obj.(type) {
+	case json.Number:
+		if d.Set(string(value)) != nil {
+			return makeConversionError("json", value, *d)
+		}
+		return nil
+	case string:
+		if d.Set(value) != nil || value == "" {
+			d.Valid = false
+			return makeParseError("parse", value, *d)
+		}
+		return nil
+	case nil:
+		d.Valid = false
+		return nil
+	default:
+		d.Valid = false
+		return makeTypeError("json", value, "json.Number", "string", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is parsed like Set does, except that the empty string produces
+// a ParseError. If obj's type is int64, d becomes valid, and the underlying
+// value of d becomes obj, with scale 0. If obj's type is float64, d becomes
+// valid, and the underlying value of d is set like DecimalFromFloat does,
+// unless obj is NaN or infinite, in which case a ConversionError is
+// returned. If obj is nil, d becomes invalid. If obj's type is any other
+// type, d becomes invalid, and a TypeError is returned.
+func (d *Decimal) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case string:
+		return d.scanString(value)
+	case []byte:
+		return d.scanString(string(value))
+	case int64:
+		d.assign(DecimalFrom(value, 0))
+		return nil
+	case float64:
+		d.assign(DecimalFromFloat(value))
+		if !d.Valid {
+			return makeConversionError("sql", value, *d)
+		}
+		return nil
+	case nil:
+		d.Valid = false
+		return nil
+	default:
+		d.Valid = false
+		return makeTypeError("sql", value, "string", "[]byte", "int64",
+			"float64", "nil")
+	}
+}
+
+// scanString parses a decimal returned by a database driver as a string.
+// Unlike Set, the empty string is not accepted.
+func (d *Decimal) scanString(str string) error {
+	if d.Set(str) != nil || str == "" {
+		d.Valid = false
+		return makeParseError("sql", str, *d)
+	}
+	return nil
+}
+
+// assign sets the underlying value and validity of d to those of v,
+// preserving the Format of d.
+func (d *Decimal) assign(v Decimal) {
+	v.Format = d.Format
+	*d = v
+}
+
+// with returns a Decimal with the Format of d whose value is
+// unscaled * 10^-scale. It is invalid if unscaled is nil, or if it has more
+// digits than maxDecimalDigits, so that arithmetic never produces a Decimal
+// which Set would reject.
+func (d Decimal) with(unscaled *big.Int, scale int) Decimal {
+	// each digit takes more than 3 bits, so shorter numbers are within limits
+	if unscaled != nil && unscaled.BitLen() > 3*maxDecimalDigits &&
+		len(new(big.Int).Abs(unscaled).String()) > maxDecimalDigits {
+		unscaled = nil
+	}
+	return Decimal{
+		Unscaled: unscaled,
+		Scale:    scale,
+		Valid:    unscaled != nil,
+		Format:   d.Format,
+	}
+}
+
+// unscaled returns the unscaled value of d, where nil becomes zero.
+func (d Decimal) unscaled() *big.Int {
+	if d.Unscaled == nil {
+		return new(big.Int)
+	}
+	return d.Unscaled
+}
+
+// alignDecimals returns copies of the unscaled values of d and e rescaled to
+// the greater of their scales, and the scale itself.
+func alignDecimals(d, e Decimal) (x, y *big.Int, scale int) {
+	x = new(big.Int).Set(d.unscaled())
+	y = new(big.Int).Set(e.unscaled())
+	if d.Scale < e.Scale {
+		x.Mul(x, pow10(e.Scale-d.Scale))
+		return x, y, e.Scale
+	}
+	y.Mul(y, pow10(d.Scale-e.Scale))
+	return x, y, d.Scale
+}
+
+// pow10 returns 10^n as a big.Int.
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// parseDecimal parses str, a decimal number optionally signed and optionally
+// in scientific notation, into a valid Decimal. ok is false if str is not
+// well formed, its scale or exponent exceeds maxDecimalScale, or its count of
+// digits exceeds maxDecimalDigits, in which case the returned Decimal is
+// invalid.
+func parseDecimal(str string) (d Decimal, ok bool) {
+	mant, exp := str, 0
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		var err error
+		mant = str[:i]
+		exp, err = strconv.Atoi(str[i+1:])
+		if err != nil || exp > maxDecimalScale || exp < -maxDecimalScale {
+			return Decimal{}, false
+		}
+	}
+
+	neg := false
+	if mant != "" && (mant[0] == '-' || mant[0] == '+') {
+		neg = mant[0] == '-'
+		mant = mant[1:]
+	}
+	whole, frac := mant, ""
+	if i := strings.IndexByte(mant, '.'); i >= 0 {
+		whole, frac = mant[:i], mant[i+1:]
+	}
+	digits := whole + frac
+	if digits == "" || strings.Trim(digits, "0123456789") != "" ||
+		len(frac) > maxDecimalScale || len(digits) > maxDecimalDigits {
+		return Decimal{}, false
+	}
+
+	unscaled, _ := new(big.Int).SetString(digits, 10)
+	if neg {
+		unscaled.Neg(unscaled)
+	}
+	scale := len(frac) - exp
+	if scale < 0 {
+		unscaled.Mul(unscaled, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{Unscaled: unscaled, Scale: scale, Valid: true}, true
+}
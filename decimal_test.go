@@ -0,0 +1,398 @@
+package null_test
+
+import (
+	"math"
+	"math/big"
+	"null"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecimalFrom(t *testing.T) {
+	cases := []struct {
+		decimal null.Decimal
+		string  string
+	}{
+		{null.DecimalFrom(1250, 2), "12.50"},
+		{null.DecimalFrom(-5, 3), "-0.005"},
+		{null.DecimalFrom(7, -1), "7"},
+		{null.DecimalFromBig(big.NewInt(42), 1), "4.2"},
+		{null.DecimalFromBig(nil, 1), "<invalid>"},
+		{null.DecimalFromZero(big.NewInt(42), 1), "4.2"},
+		{null.DecimalFromZero(big.NewInt(0), 1), "<invalid>"},
+		{null.DecimalFromZero(nil, 1), "<invalid>"},
+		{null.DecimalFromFloat(0.1), "0.1"},
+		{null.DecimalFromFloat(-1e21), "-1000000000000000000000"},
+		{null.DecimalFromFloat(math.NaN()), "<invalid>"},
+		{null.DecimalFromFloat(math.Inf(1)), "<invalid>"},
+		{null.Decimal{Scale: 2, Valid: true}, "0.00"},
+		{null.Decimal{}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.decimal.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.decimal.String(),
+			)
+		}
+	}
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	a := null.DecimalFrom(1050, 2) // 10.50
+	b := null.DecimalFrom(25, 1)   // 2.5
+	invalid := null.Decimal{}
+
+	cases := []struct {
+		result null.Decimal
+		string string
+	}{
+		{a.Add(b), "13.00"},
+		{a.Sub(b), "8.00"},
+		{b.Sub(a), "-8.00"},
+		{a.Mul(b), "26.250"},
+		{a.Add(invalid), "<invalid>"},
+		{invalid.Sub(a), "<invalid>"},
+		{invalid.Mul(invalid), "<invalid>"},
+		{null.DecimalFrom(12345, 3).Round(2), "12.35"},
+		{null.DecimalFrom(-12345, 3).Round(2), "-12.35"},
+		{null.DecimalFrom(12344, 3).Round(2), "12.34"},
+		{null.DecimalFrom(-12344, 3).Round(0), "-12"},
+		{null.DecimalFrom(995, 3).Round(2), "1.00"},
+		{null.DecimalFrom(15, 1).Round(-1), "2"},
+		{null.DecimalFrom(15, 1).Round(3), "1.5"},
+		{invalid.Round(2), "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.result.String() {
+			t.Fatalf(
+				"%s, case #%d: result mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.result.String(),
+			)
+		}
+	}
+
+	if a.String() != "10.50" || b.String() != "2.5" {
+		t.Fatalf("%s: operands were modified", t.Name())
+	}
+}
+
+func TestDecimal_MulLimits(t *testing.T) {
+	// 0.5 with 10000 fractional digits, squared
+	var d null.Decimal
+	if err := d.Set("0." + strings.Repeat("0", 9999) + "5"); err != nil {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+	p := d.Mul(d)
+	if !p.Valid || p.Scale != 16383 {
+		t.Fatalf("%s: unexpected product scale %d", t.Name(), p.Scale)
+	}
+	var q null.Decimal
+	if err := q.Set(p.String()); err != nil {
+		t.Fatalf("%s: product does not parse back: %v", t.Name(), err)
+	}
+	if q.Cmp(p) != null.IntFrom(0) {
+		t.Fatalf("%s: product mismatch after round trip", t.Name())
+	}
+
+	// 100000 integer digits, squared
+	if err := d.Set("9" + strings.Repeat("0", 99999)); err != nil {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+	if d.Mul(d).Valid {
+		t.Fatalf("%s: product with too many digits is valid", t.Name())
+	}
+}
+
+func TestDecimal_Cmp(t *testing.T) {
+	cases := []struct {
+		a, b null.Decimal
+		cmp  null.Int
+	}{
+		{null.DecimalFrom(1, 0), null.DecimalFrom(100, 2), null.IntFrom(0)},
+		{null.DecimalFrom(-1, 0), null.DecimalFrom(1, 3), null.IntFrom(-1)},
+		{null.DecimalFrom(2, 1), null.DecimalFrom(1, 1), null.IntFrom(1)},
+		{null.DecimalFrom(1, 0), null.Decimal{}, null.Int{}},
+		{null.Decimal{}, null.DecimalFrom(1, 0), null.Int{}},
+	}
+
+	for n, c := range cases {
+		cmp := c.a.Cmp(c.b)
+		if c.cmp != cmp {
+			t.Fatalf(
+				"%s, case #%d: comparison mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.cmp, cmp,
+			)
+		}
+	}
+}
+
+func TestDecimal_Rat(t *testing.T) {
+	if r := null.DecimalFrom(-125, 2).Rat(); r.Cmp(big.NewRat(-5, 4)) != 0 {
+		t.Fatalf("%s: rational mismatch (expected -5/4, got %v)", t.Name(), r)
+	}
+	if r := (null.Decimal{}).Rat(); r != nil {
+		t.Fatalf("%s: invalid decimal produced %v", t.Name(), r)
+	}
+}
+
+func TestDecimal_Ptr(t *testing.T) {
+	d := null.DecimalFrom(-125, 2)
+	if r := d.Ptr(); r.Cmp(big.NewRat(-5, 4)) != 0 {
+		t.Fatalf("%s: rational mismatch (expected -5/4, got %v)", t.Name(), r)
+	}
+	if r := (null.Decimal{}).Ptr(); r != nil {
+		t.Fatalf("%s: invalid decimal produced %v", t.Name(), r)
+	}
+	if r := (null.Decimal{}).Zero(); r.Sign() != 0 {
+		t.Fatalf("%s: zero mismatch (expected 0, got %v)", t.Name(), r)
+	}
+
+	v := big.NewInt(125)
+	d.From(v, 1)
+	v.SetInt64(0)
+	if d.String() != "12.5" {
+		t.Fatalf(
+			"%s: value not copied (expected '12.5', got '%s')",
+			t.Name(), d.String(),
+		)
+	}
+	d.FromZero(v, 1)
+	if d.Valid {
+		t.Fatalf("%s: zero decimal is valid", t.Name())
+	}
+}
+
+func TestDecimal_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		format   null.DecimalFormat
+		nullable null.Decimal
+		json     []byte
+	}{
+		{null.DecimalNumberFormat, null.DecimalFrom(1999, 2), []byte("19.99")},
+		{null.DecimalStringFormat, null.DecimalFrom(1999, 2), []byte(`"19.99"`)},
+		{null.DecimalNumberFormat, null.Decimal{}, []byte("null")},
+		{null.DecimalStringFormat, null.Decimal{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		c.nullable.Format = c.format
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestDecimal_Format(t *testing.T) {
+	d := null.Decimal{Format: null.DecimalStringFormat}
+	if err := d.Set("1.50"); err != nil {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+	sum := d.Add(null.DecimalFrom(1, 0))
+
+	for n, v := range []null.Decimal{d, sum, d.Mul(null.Decimal{})} {
+		if v.Format != null.DecimalStringFormat {
+			t.Fatalf("%s, case #%d: format not preserved", t.Name(), n+1)
+		}
+	}
+	if b, _ := sum.MarshalJSON(); string(b) != `"2.50"` {
+		t.Fatalf(
+			"%s: json mismatch (expected '%s', got '%s')",
+			t.Name(), `"2.50"`, string(b),
+		)
+	}
+}
+
+func TestDecimal_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.Decimal
+		value    interface{}
+	}{
+		{null.DecimalFrom(-1999, 2), "-19.99"},
+		{null.Decimal{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestDecimal_Set(t *testing.T) {
+	var d null.Decimal
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		result  string
+		errType reflect.Type
+	}{
+		{"12.50", "12.50", nilType},
+		{"-0.001", "-0.001", nilType},
+		{"+7", "7", nilType},
+		{".5", "0.5", nilType},
+		{"5.", "5", nilType},
+		{"1.25e3", "1250", nilType},
+		{"125E-4", "0.0125", nilType},
+		{"123456789012345678901234567890.12", "123456789012345678901234567890.12",
+			nilType},
+		{"", "<invalid>", nilType},
+		{".", "<invalid>", parseErrType},
+		{"-", "<invalid>", parseErrType},
+		{"1.2.3", "<invalid>", parseErrType},
+		{"1e", "<invalid>", parseErrType},
+		{"1e99999", "<invalid>", parseErrType},
+		{strings.Repeat("9", 200000), "<invalid>", parseErrType},
+		{"0x10", "<invalid>", parseErrType},
+		{"NaN", "<invalid>", parseErrType},
+	}
+
+	for n, c := range cases {
+		err := d.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.result != d.String() {
+			t.Fatalf(
+				"%s, case #%d: result mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.result, d.String(),
+			)
+		}
+	}
+}
+
+func TestDecimal_UnmarshalText(t *testing.T) {
+	var d null.Decimal
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("1.5"), true, nilType},
+		{nil, false, nilType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := d.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != d.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, d.Valid,
+			)
+		}
+	}
+}
+
+func TestDecimal_UnmarshalJSON(t *testing.T) {
+	var d null.Decimal
+	nilType := reflect.TypeOf(nil)
+	convErrType := reflect.TypeOf(null.ConversionError{})
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		result  string
+		errType reflect.Type
+	}{
+		{[]byte("0.10"), "0.10", nilType},
+		{[]byte("12345678901234567890.5"), "12345678901234567890.5", nilType},
+		{[]byte(`"-3.25"`), "-3.25", nilType},
+		{[]byte("null"), "<invalid>", nilType},
+		{[]byte("1e99999"), "<invalid>", convErrType},
+		{[]byte(`""`), "<invalid>", parseErrType},
+		{[]byte(`"x"`), "<invalid>", parseErrType},
+		{[]byte("true"), "<invalid>", typeErrType},
+		{nil, "<invalid>", unmarshalErrType},
+		{[]byte("x"), "<invalid>", unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := d.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.result != d.String() {
+			t.Fatalf(
+				"%s, case #%d: result mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.result, d.String(),
+			)
+		}
+	}
+}
+
+func TestDecimal_Scan(t *testing.T) {
+	var d null.Decimal
+	nilType := reflect.TypeOf(nil)
+	convErrType := reflect.TypeOf(null.ConversionError{})
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		result  string
+		errType reflect.Type
+	}{
+		{"19.99", "19.99", nilType},
+		{[]byte("-0.50"), "-0.50", nilType},
+		{int64(42), "42", nilType},
+		{0.25, "0.25", nilType},
+		{nil, "<invalid>", nilType},
+		{"", "<invalid>", parseErrType},
+		{[]byte("x"), "<invalid>", parseErrType},
+		{math.Inf(-1), "<invalid>", convErrType},
+		{true, "<invalid>", typeErrType},
+	}
+
+	for n, c := range cases {
+		err := d.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.result != d.String() {
+			t.Fatalf(
+				"%s, case #%d: result mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.result, d.String(),
+			)
+		}
+	}
+}
@@ -0,0 +1,425 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationFormat selects the JSON representation of Duration.
+type DurationFormat int
+
+const (
+	// DurationStringFormat encodes Duration as a JSON string, formatted like
+	// time.Duration.String does, such as "1h30m0s".
+	DurationStringFormat DurationFormat = iota
+
+	// DurationIntegerFormat encodes Duration as a JSON number holding the
+	// count of nanoseconds.
+	DurationIntegerFormat
+)
+
+// Duration implements a nullable time.Duration.
+type Duration struct {
+	// Duration holds the underlying time.Duration value.
+	Duration time.Duration
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+
+	// Format holds the format used by the MarshalJSON method. It defaults to
+	// DurationStringFormat, and it is preserved by all the methods which
+	// modify d. UnmarshalJSON accepts both formats regardless.
+	Format DurationFormat
+}
+
+// DurationFrom creates a valid Duration from v.
+func DurationFrom(v time.Duration) Duration {
+	return DurationFromPtr(&v)
+}
+
+// DurationFromPtr creates a Duration from pointer p. If p is nil,
+// the returned Duration is invalid.
+func DurationFromPtr(p *time.Duration) Duration {
+	if p != nil {
+		return Duration{
+			Duration: *p,
+			Valid:    true,
+		}
+	}
+	return Duration{}
+}
+
+// DurationFromZero creates a Duration from v. If v is 0,
+// the returned Duration is invalid.
+func DurationFromZero(v time.Duration) Duration {
+	return Duration{
+		Duration: v,
+		Valid:    v != 0,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of d if d is valid,
+// otherwise returns nil.
+func (d Duration) Ptr() *time.Duration {
+	if d.Valid {
+		return &d.Duration
+	}
+	return nil
+}
+
+// Zero returns the underlying value of d if d is valid, otherwise returns 0.
+func (d Duration) Zero() time.Duration {
+	if d.Valid {
+		return d.Duration
+	}
+	return 0
+}
+
+// From sets the underlying value of d to v. d becomes valid.
+func (d *Duration) From(v time.Duration) {
+	d.Duration = v
+	d.Valid = true
+}
+
+// FromPtr invalidates d if p is nil, otherwise it sets the underlying value
+// of d to the value pointed to by p, and d becomes valid.
+func (d *Duration) FromPtr(p *time.Duration) {
+	d.Valid = p != nil
+	if p != nil {
+		d.Duration = *p
+	}
+}
+
+// FromZero invalidates d if v is 0, otherwise it sets the underlying value
+// of d to v, and d becomes valid.
+func (d *Duration) FromZero(v time.Duration) {
+	d.Duration = v
+	d.Valid = v != 0
+}
+
+// IsValid returns true if d is valid.
+func (d Duration) IsValid() bool {
+	return d.Valid
+}
+
+// Invalidate makes d invalid.
+func (d *Duration) Invalidate() {
+	d.Valid = false
+}
+
+// Interface returns the underlying value of d if d is valid, otherwise nil.
+func (d Duration) Interface() interface{} {
+	if d.Valid {
+		return d.Duration
+	}
+	return nil
+}
+
+// SetInterface invalidates d if v is nil, otherwise if v's type is
+// time.Duration, it sets the underlying value of d to v, and d becomes valid.
+// If v's type is any other type, d becomes invalid,
+// and a TypeError is returned.
+func (d *Duration) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case time.Duration:
+		d.From(value)
+		return nil
+	case nil:
+		d.Valid = false
+		return nil
+	default:
+		d.Valid = false
+		return makeTypeError("set", value, "time.Duration", "nil")
+	}
+}
+
+// String returns a string representation of d. If d is valid,
+// it returns a string representation of the underlying value of d
+// as formatted by time.Duration.String, otherwise it returns
+// InvalidNullableString.
+func (d Duration) String() string {
+	if d.Valid {
+		return d.Duration.String()
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals d to a byte string representation. If d is valid,
+// it formats the underlying value of d like time.Duration.String does,
+// otherwise it returns nil. err is always nil.
+func (d Duration) MarshalText() (data []byte, err error) {
+	if d.Valid {
+		return []byte(d.Duration.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of d according to the Format of d
+// if d is valid, otherwise it returns the JSON null value.
+// err is always nil.
+func (d Duration) MarshalJSON() (data []byte, err error) {
+	if !d.Valid {
+		return jNull, nil
+	}
+	if d.Format == DurationIntegerFormat {
+		return []byte(strconv.FormatInt(int64(d.Duration), 10)), nil
+	}
+	return []byte(`"` + d.Duration.String() + `"`), nil
+}
+
+// Value returns the count of nanoseconds of the underlying value of d as an
+// int64 if d is valid, otherwise nil. err is always nil.
+func (d Duration) Value() (v driver.Value, err error) {
+	if d.Valid {
+		return int64(d.Duration), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates d if str is the empty string, otherwise it parses str into
+// the underlying value of d, and d becomes valid. str may use the syntax of
+// time.ParseDuration, such as 1h30m, or the ISO 8601 duration syntax,
+// such as PT1H30M. ISO 8601 years and months are rejected, since their
+// length is not fixed. If str cannot be parsed, d becomes invalid and a
+// ParseError is returned.
+func (d *Duration) Set(str string) error {
+	if str == "" {
+		d.Valid = false
+		return nil
+	}
+
+	var err error
+	d.Duration, err = time.ParseDuration(str)
+	if err != nil {
+		d.Duration, err = parseISODuration(str)
+	}
+	d.Valid = err == nil
+	if err != nil {
+		return makeParseError("parse", str, *d)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to d.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (d *Duration) UnmarshalText(text []byte) error {
+	if d.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *d)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to d.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, d becomes invalid. If the encoded JSON data
+// represent a JSON string that Set accepts, d becomes valid, and the
+// underlying value of d is set to the parsed duration, otherwise a
+// ParseError is returned. If the encoded JSON data represent a JSON number,
+// and it is an integer that fits in an int64, d becomes valid, and the
+// underlying value of d is set to the JSON number as a count of nanoseconds,
+// otherwise a ConversionError is returned. Other JSON types produce a
+// TypeError. Malformed JSON produces an UnmarshalError.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	obj, ok := decodeJSONNumber(data)
+	if !ok {
+		d.Valid = false
+		return makeUnmarshalError("json", data, *d)
+	}
+	switch value := obj.(type) {
+	case string:
+		if d.Set(value) != nil || value == "" {
+			d.Valid = false
+			return makeParseError("parse", value, *d)
+		}
+		return nil
+	case json.Number:
+		n, err := value.Int64()
+		if errors.Is(err, strconv.ErrRange) {
+			err = makeConversionError("json", value, time.Duration(n))
+		} else if err != nil {
+			// accept integral numbers in other notations, such as 1e3
+			f, _ := value.Float64()
+			n = int64(f)
+			err = nil
+			if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+				err = makeConversionError("json", value, time.Duration(n))
+			}
+		}
+		d.Duration = time.Duration(n)
+		d.Valid = err == nil
+		return err
+	case nil:
+		d.Valid = false
+		return nil
+	default:
+		d.Valid = false
+		return makeTypeError("json", value, "string", "json.Number", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is int64,
+// d becomes valid, and the underlying value of d becomes obj nanoseconds.
+// If obj's type is string or []byte, it is parsed like Set does, and
+// additionally the PostgreSQL interval format, such as 1 day 01:30:00,
+// is accepted, since it is what drivers return for INTERVAL columns.
+// If it cannot be parsed, d becomes invalid, and a ParseError is returned.
+// If obj is nil, d becomes invalid. If obj's type is any other type,
+// d becomes invalid, and a TypeError is returned.
+func (d *Duration) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case int64:
+		d.Duration = time.Duration(value)
+		d.Valid = true
+		return nil
+	case string:
+		return d.scanString(value)
+	case []byte:
+		return d.scanString(string(value))
+	case nil:
+		d.Valid = false
+		return nil
+	default:
+		d.Valid = false
+		return makeTypeError("sql", value, "int64", "string", "[]byte", "nil")
+	}
+}
+
+// scanString parses an interval returned by a database driver as a string.
+// Unlike Set, the empty string is not accepted.
+func (d *Duration) scanString(str string) error {
+	if str != "" && d.Set(str) == nil {
+		return nil
+	}
+
+	var err error
+	d.Duration, err = parseSQLInterval(str)
+	d.Valid = err == nil
+	if err != nil {
+		return makeParseError("sql", str, *d)
+	}
+	return nil
+}
+
+// errDurationSyntax is returned by the duration parsers when their input is
+// not in the expected format.
+var errDurationSyntax = errors.New("invalid duration syntax")
+
+// parseISODuration parses an ISO 8601 duration, such as P1DT2H30M or -PT0.5S.
+// Weeks and days are assumed to last 168 and 24 hours respectively.
+// Components may hold a fraction, separated by a period or a comma.
+func parseISODuration(str string) (time.Duration, error) {
+	s, sign := str, ""
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		s, sign = s[1:], s[:1]
+	}
+	if len(s) < 2 || s[0] != 'P' {
+		return 0, errDurationSyntax
+	}
+	s = s[1:]
+
+	var total time.Duration
+	designators, inTime := "WD", false
+	for s != "" {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return 0, errDurationSyntax
+			}
+			s, designators, inTime = s[1:], "HMS", true
+			continue
+		}
+
+		i := strings.IndexAny(s, "WDHMSYT")
+		if i <= 0 || s[i] == 'T' {
+			return 0, errDurationSyntax
+		}
+		num, des := strings.Replace(s[:i], ",", ".", 1), s[i]
+		s = s[i+1:]
+
+		// designators must appear in order, each at most once
+		j := strings.IndexByte(designators, des)
+		if j < 0 || strings.ContainsAny(num, "+-") {
+			return 0, errDurationSyntax
+		}
+		designators = designators[j+1:]
+
+		var unit string
+		var hours int64
+		switch des {
+		case 'W':
+			unit, hours = "h", 168
+		case 'D':
+			unit, hours = "h", 24
+		default:
+			unit, hours = strings.ToLower(string(des)), 1
+		}
+		v, err := time.ParseDuration(num + unit)
+		if err != nil || v > time.Duration(math.MaxInt64/hours) {
+			return 0, errDurationSyntax
+		}
+		v *= time.Duration(hours)
+		if total > math.MaxInt64-v {
+			return 0, errDurationSyntax
+		}
+		total += v
+	}
+	if sign == "-" {
+		total = -total
+	}
+	return total, nil
+}
+
+// parseSQLInterval parses an interval in the PostgreSQL default output
+// format, made of an optional day count followed by "day" or "days",
+// and an optional signed clock formatted as HH:MM:SS, where the seconds may
+// hold a fraction.
+func parseSQLInterval(str string) (time.Duration, error) {
+	fields := strings.Fields(str)
+	if len(fields) == 0 {
+		return 0, errDurationSyntax
+	}
+
+	var total time.Duration
+	for i := 0; i < len(fields); i++ {
+		var v time.Duration
+		if f := fields[i]; strings.Contains(f, ":") {
+			sign := ""
+			if f[0] == '-' || f[0] == '+' {
+				f, sign = f[1:], f[:1]
+			}
+			clock := strings.Split(f, ":")
+			if len(clock) != 3 {
+				return 0, errDurationSyntax
+			}
+			var err error
+			v, err = time.ParseDuration(
+				sign + clock[0] + "h" + clock[1] + "m" + clock[2] + "s",
+			)
+			if err != nil || i != len(fields)-1 {
+				return 0, errDurationSyntax
+			}
+		} else {
+			n, err := strconv.ParseInt(f, 10, 64)
+			if err != nil || i+1 == len(fields) ||
+				(fields[i+1] != "day" && fields[i+1] != "days") ||
+				n > math.MaxInt64/int64(24*time.Hour) ||
+				n < math.MinInt64/int64(24*time.Hour) {
+				return 0, errDurationSyntax
+			}
+			v = time.Duration(n) * 24 * time.Hour
+			i++
+		}
+		if (v > 0 && total > math.MaxInt64-v) ||
+			(v < 0 && total < math.MinInt64-v) {
+			return 0, errDurationSyntax
+		}
+		total += v
+	}
+	return total, nil
+}
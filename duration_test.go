@@ -0,0 +1,299 @@
+package null_test
+
+import (
+	"encoding/json"
+	"null"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDuration_String(t *testing.T) {
+	cases := []struct {
+		nullable null.Duration
+		string   string
+	}{
+		{null.DurationFrom(90 * time.Minute), "1h30m0s"},
+		{null.DurationFrom(0), "0s"},
+		{null.Duration{}, "<invalid>"},
+		{null.Duration{Duration: time.Second}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestDuration_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		format   null.DurationFormat
+		nullable null.Duration
+		json     []byte
+	}{
+		{
+			null.DurationStringFormat,
+			null.DurationFrom(1500 * time.Millisecond),
+			[]byte(`"1.5s"`),
+		},
+		{
+			null.DurationIntegerFormat,
+			null.DurationFrom(1500 * time.Millisecond),
+			[]byte("1500000000"),
+		},
+		{null.DurationStringFormat, null.Duration{}, []byte("null")},
+		{null.DurationIntegerFormat, null.Duration{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		c.nullable.Format = c.format
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestDuration_Format(t *testing.T) {
+	v := struct {
+		Timeout null.Duration `json:"timeout"`
+	}{null.Duration{Format: null.DurationIntegerFormat}}
+
+	if err := json.Unmarshal([]byte(`{"timeout":"2s"}`), &v); err != nil {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+	if string(b) != `{"timeout":2000000000}` {
+		t.Fatalf(
+			"%s: format not preserved (expected '%s', got '%s')",
+			t.Name(), `{"timeout":2000000000}`, string(b),
+		)
+	}
+}
+
+func TestDuration_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.Duration
+		value    interface{}
+	}{
+		{null.DurationFrom(time.Second), int64(1000000000)},
+		{null.Duration{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestDuration_Set(t *testing.T) {
+	var d null.Duration
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string   string
+		duration time.Duration
+		valid    bool
+		errType  reflect.Type
+	}{
+		{"1h30m", 90 * time.Minute, true, nilType},
+		{"-1.5s", -1500 * time.Millisecond, true, nilType},
+		{"0", 0, true, nilType},
+		{"PT1H30M", 90 * time.Minute, true, nilType},
+		{"P1DT12H", 36 * time.Hour, true, nilType},
+		{"P2W", 336 * time.Hour, true, nilType},
+		{"PT0,5S", 500 * time.Millisecond, true, nilType},
+		{"-PT1M", -time.Minute, true, nilType},
+		{"", 0, false, nilType},
+		{"P", 0, false, parseErrType},
+		{"PT", 0, false, parseErrType},
+		{"P1Y", 0, false, parseErrType},
+		{"P1M", 0, false, parseErrType},
+		{"PT1M1H", 0, false, parseErrType},
+		{"P1H", 0, false, parseErrType},
+		{"PT-1H", 0, false, parseErrType},
+		{"P1DT", 0, false, parseErrType},
+		{"P200000W", 0, false, parseErrType},
+		{"1 hour", 0, false, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := d.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != d.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, d.Valid,
+			)
+		}
+		if c.valid && c.duration != d.Duration {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.duration, d.Duration,
+			)
+		}
+	}
+}
+
+func TestDuration_UnmarshalText(t *testing.T) {
+	var d null.Duration
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("10s"), true, nilType},
+		{[]byte("PT10S"), true, nilType},
+		{nil, false, nilType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := d.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != d.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, d.Valid,
+			)
+		}
+	}
+}
+
+func TestDuration_UnmarshalJSON(t *testing.T) {
+	var d null.Duration
+	nilType := reflect.TypeOf(nil)
+	convErrType := reflect.TypeOf(null.ConversionError{})
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json     []byte
+		duration time.Duration
+		valid    bool
+		errType  reflect.Type
+	}{
+		{[]byte(`"1m"`), time.Minute, true, nilType},
+		{[]byte(`"PT1M"`), time.Minute, true, nilType},
+		{[]byte("60000000000"), time.Minute, true, nilType},
+		{[]byte("6e10"), time.Minute, true, nilType},
+		{[]byte("null"), 0, false, nilType},
+		{[]byte(`""`), 0, false, parseErrType},
+		{[]byte(`"x"`), 0, false, parseErrType},
+		{[]byte("1.5"), 0, false, convErrType},
+		{[]byte("9223372036854775808"), 0, false, convErrType},
+		{[]byte("true"), 0, false, typeErrType},
+		{nil, 0, false, unmarshalErrType},
+		{[]byte("x"), 0, false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := d.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != d.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, d.Valid,
+			)
+		}
+		if c.valid && c.duration != d.Duration {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.duration, d.Duration,
+			)
+		}
+	}
+}
+
+func TestDuration_Scan(t *testing.T) {
+	var d null.Duration
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source   interface{}
+		duration time.Duration
+		valid    bool
+		errType  reflect.Type
+	}{
+		{int64(1000), time.Microsecond, true, nilType},
+		{"1h", time.Hour, true, nilType},
+		{[]byte("PT1H"), time.Hour, true, nilType},
+		{"01:30:00", 90 * time.Minute, true, nilType},
+		{"-00:00:01.5", -1500 * time.Millisecond, true, nilType},
+		{"1 day 02:00:00", 26 * time.Hour, true, nilType},
+		{"-1 days +02:00:00", -22 * time.Hour, true, nilType},
+		{"3 days", 72 * time.Hour, true, nilType},
+		{nil, 0, false, nilType},
+		{"", 0, false, parseErrType},
+		{"1 mon", 0, false, parseErrType},
+		{"01:30", 0, false, parseErrType},
+		{"01:00:00 1 day", 0, false, parseErrType},
+		{[]byte("x"), 0, false, parseErrType},
+		{1.5, 0, false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := d.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != d.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, d.Valid,
+			)
+		}
+		if c.valid && c.duration != d.Duration {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.duration, d.Duration,
+			)
+		}
+	}
+}
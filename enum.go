@@ -0,0 +1,288 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"slices"
+)
+
+// Enumerable is satisfied by the string types which declare their allowed
+// values with a Values method, for instance:
+//
+//	type Status string
+//
+//	func (Status) Values() []Status {
+//	    return []Status{"active", "suspended"}
+//	}
+type Enumerable[T any] interface {
+	~string
+	Values() []T
+}
+
+// Enum implements a nullable T, where T is a string type whose values are
+// restricted to the set returned by its Values method, such as the values of
+// a status column. Values are checked against the allowed set when they are
+// parsed by Set, UnmarshalText, UnmarshalJSON and Scan, and when they are
+// stored by Value, which return an EnumError if a value is not allowed.
+// Since the allowed set belongs to T, every Enum[T] is checked, including
+// the zero value, and Enum values can be compared with ==.
+type Enum[T Enumerable[T]] struct {
+	// Val holds the underlying T value.
+	Val T
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// EnumFrom creates a valid Enum from v.
+func EnumFrom[T Enumerable[T]](v T) Enum[T] {
+	return EnumFromPtr(&v)
+}
+
+// EnumFromPtr creates an Enum from pointer p. If p is nil,
+// the returned Enum is invalid.
+func EnumFromPtr[T Enumerable[T]](p *T) Enum[T] {
+	if p != nil {
+		return Enum[T]{
+			Val:   *p,
+			Valid: true,
+		}
+	}
+	return Enum[T]{}
+}
+
+// EnumFromZero creates an Enum from v. If v is the empty string,
+// the returned Enum is invalid.
+func EnumFromZero[T Enumerable[T]](v T) Enum[T] {
+	return Enum[T]{
+		Val:   v,
+		Valid: v != "",
+	}
+}
+
+// Ptr returns a pointer to the underlying value of e if e is valid,
+// otherwise returns nil.
+func (e Enum[T]) Ptr() *T {
+	if e.Valid {
+		return &e.Val
+	}
+	return nil
+}
+
+// Zero returns the underlying value of e if e is valid, otherwise
+// returns the empty string.
+func (e Enum[T]) Zero() T {
+	if e.Valid {
+		return e.Val
+	}
+	return ""
+}
+
+// From sets the underlying value of e to v. e becomes valid. v is not
+// checked against the allowed set of T until e is stored by Value.
+func (e *Enum[T]) From(v T) {
+	e.Valid = true
+	e.Val = v
+}
+
+// FromPtr invalidates e if p is nil, otherwise it sets the underlying value
+// of e to the value pointed to by p, and e becomes valid.
+func (e *Enum[T]) FromPtr(p *T) {
+	e.Valid = p != nil
+	if p != nil {
+		e.Val = *p
+	}
+}
+
+// FromZero invalidates e if v is the empty string, otherwise it sets the
+// underlying value of e to v, and e becomes valid.
+func (e *Enum[T]) FromZero(v T) {
+	e.Valid = v != ""
+	e.Val = v
+}
+
+// IsValid returns true if e is valid.
+func (e Enum[T]) IsValid() bool {
+	return e.Valid
+}
+
+// Invalidate makes e invalid.
+func (e *Enum[T]) Invalidate() {
+	e.Valid = false
+}
+
+// Interface returns the underlying value of e as T if e is valid,
+// otherwise nil.
+func (e Enum[T]) Interface() interface{} {
+	if e.Valid {
+		return e.Val
+	}
+	return nil
+}
+
+// SetInterface invalidates e if v is nil, otherwise if v's type is T,
+// it sets the underlying value of e to v, and e becomes valid.
+// If v's type is any other type, e becomes invalid, and a TypeError is
+// returned.
+func (e *Enum[T]) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case T:
+		e.From(value)
+		return nil
+	case nil:
+		e.Valid = false
+		return nil
+	default:
+		e.Valid = false
+		return makeTypeError("set", value, typeName(e.Val), "nil")
+	}
+}
+
+// Allowed returns a copy of the allowed set of T, in the order returned by
+// its Values method, for instance to generate documentation or schemas.
+func (e Enum[T]) Allowed() []T {
+	return slices.Clone(e.Val.Values())
+}
+
+// String returns the underlying value of e if e is valid,
+// and InvalidNullableString if not valid.
+func (e Enum[T]) String() string {
+	if e.Valid {
+		return string(e.Val)
+	}
+	return InvalidNullableString
+}
+
+// MarshalText converts the underlying value of e to []byte if
+// e is valid, and returns nil if not valid. err is always nil.
+func (e Enum[T]) MarshalText() (data []byte, err error) {
+	if e.Valid {
+		return []byte(e.Val), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of e to a JSON string if e is
+// valid, otherwise it returns the JSON null value. err is always nil.
+func (e Enum[T]) MarshalJSON() (data []byte, err error) {
+	if e.Valid {
+		bytes, err := json.Marshal(string(e.Val))
+		if err != nil {
+			// this should never happen
+			panic(err)
+		}
+		return bytes, nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of e as a string if e is valid,
+// otherwise nil. If the underlying value of e is not in the allowed set of
+// T, such as a value set by From, an EnumError is returned, so that it never
+// reaches the database.
+func (e Enum[T]) Value() (v driver.Value, err error) {
+	if !e.Valid {
+		return nil, nil
+	}
+	if !e.allowed(e.Val) {
+		return nil, e.enumError("sql", e.Val)
+	}
+	return string(e.Val), nil
+}
+
+// Set invalidates e if str is the empty string, otherwise it sets the
+// underlying value of e to str, and e becomes valid. If str is not in the
+// allowed set of T, e becomes invalid and an EnumError is returned.
+func (e *Enum[T]) Set(str string) error {
+	if str == "" {
+		e.Valid = false
+		return nil
+	}
+	return e.set("parse", T(str))
+}
+
+// UnmarshalText unmarshals from a byte string to e. It behaves like Set,
+// except that the prefix of the returned EnumError is "text".
+func (e *Enum[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		e.Valid = false
+		return nil
+	}
+	return e.set("text", T(text))
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to e.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, e becomes invalid. If the encoded JSON data
+// represent a JSON string in the allowed set of T, e becomes valid, and the
+// underlying value of e is set to the JSON string, otherwise an EnumError is
+// returned. Other JSON types produce a TypeError. Malformed JSON produces an
+// UnmarshalError.
+func (e *Enum[T]) UnmarshalJSON(data []byte) error {
+	var obj interface{}
+	if json.Unmarshal(data, &obj) != nil {
+		e.Valid = false
+		return makeUnmarshalError("json", data, *e)
+	}
+	switch value := obj.(type) {
+	case string:
+		return e.set("json", T(value))
+	case nil:
+		e.Valid = false
+		return nil
+	default:
+		e.Valid = false
+		return makeTypeError("json", value, "string", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, e becomes valid, and the underlying value of e becomes the value
+// of obj, unless it is not in the allowed set of T, in which case e becomes
+// invalid, and an EnumError is returned. If obj is nil, e becomes invalid.
+// If obj's type is any other type, e becomes invalid, and a TypeError is
+// returned.
+func (e *Enum[T]) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case string:
+		return e.set("sql", T(value))
+	case []byte:
+		return e.set("sql", T(value))
+	case nil:
+		e.Valid = false
+		return nil
+	default:
+		e.Valid = false
+		return makeTypeError("sql", value, "string", "[]byte", "nil")
+	}
+}
+
+// set sets the underlying value of e to v, and e becomes valid. If v is not
+// in the allowed set of T, e becomes invalid, and an EnumError with the given
+// prefix is returned.
+func (e *Enum[T]) set(prefix string, v T) error {
+	if !e.allowed(v) {
+		e.Valid = false
+		return e.enumError(prefix, v)
+	}
+	e.Val = v
+	e.Valid = true
+	return nil
+}
+
+// allowed reports whether v is in the allowed set of T.
+func (e Enum[T]) allowed(v T) bool {
+	return slices.Contains(v.Values(), v)
+}
+
+// enumError builds an EnumError with the given prefix for v, which is not in
+// the allowed set of T.
+func (e Enum[T]) enumError(prefix string, v T) error {
+	values := v.Values()
+	allowed := make([]string, len(values))
+	for i, a := range values {
+		allowed[i] = string(a)
+	}
+	return makeEnumError(prefix, string(v), allowed, e.Val)
+}
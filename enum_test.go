@@ -0,0 +1,284 @@
+package null_test
+
+import (
+	"encoding/json"
+	"errors"
+	"null"
+	"reflect"
+	"testing"
+)
+
+type status string
+
+func (status) Values() []status {
+	return []status{"active", "suspended"}
+}
+
+func TestEnum_Allowed(t *testing.T) {
+	var e null.Enum[status]
+	exp := []status{"active", "suspended"}
+	if !reflect.DeepEqual(exp, e.Allowed()) {
+		t.Fatalf(
+			"%s: allowed mismatch (expected %v, got %v)",
+			t.Name(), exp, e.Allowed(),
+		)
+	}
+	e.Allowed()[0] = "deleted"
+	if e.Set("active") != nil || e.Set("deleted") == nil {
+		t.Fatalf("%s: allowed set was modified", t.Name())
+	}
+	if e.Set("active"); e != null.EnumFrom[status]("active") {
+		t.Fatalf("%s: enum should equal EnumFrom", t.Name())
+	}
+}
+
+func TestEnum_ZeroField(t *testing.T) {
+	var row struct {
+		Status null.Enum[status] `json:"status"`
+	}
+
+	err := json.Unmarshal([]byte(`{"status":"deleted"}`), &row)
+	if !errors.As(err, new(null.EnumError)) {
+		t.Fatalf("%s: unexpected json error %v", t.Name(), err)
+	}
+	if row.Status.Valid {
+		t.Fatalf("%s: enum should be invalid after json", t.Name())
+	}
+	err = json.Unmarshal([]byte(`{"status":"active"}`), &row)
+	if err != nil || row.Status != null.EnumFrom[status]("active") {
+		t.Fatalf("%s: unexpected json result %v, %v", t.Name(), row, err)
+	}
+
+	err = row.Status.Scan("deleted")
+	if !errors.As(err, new(null.EnumError)) {
+		t.Fatalf("%s: unexpected sql error %v", t.Name(), err)
+	}
+	if row.Status.Valid {
+		t.Fatalf("%s: enum should be invalid after scan", t.Name())
+	}
+}
+
+func TestEnum_String(t *testing.T) {
+	cases := []struct {
+		nullable null.Enum[status]
+		string   string
+	}{
+		{null.EnumFrom[status]("active"), "active"},
+		{null.EnumFrom[status](""), ""},
+		{null.Enum[status]{}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestEnum_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		nullable null.Enum[status]
+		json     []byte
+	}{
+		{null.EnumFrom[status]("active"), []byte(`"active"`)},
+		{null.Enum[status]{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestEnum_Value(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	enumErrType := reflect.TypeOf(null.EnumError{})
+
+	cases := []struct {
+		nullable null.Enum[status]
+		value    interface{}
+		errType  reflect.Type
+	}{
+		{null.EnumFrom[status]("active"), "active", nilType},
+		{null.Enum[status]{}, nil, nilType},
+		{null.EnumFrom[status]("deleted"), nil, enumErrType},
+		{null.EnumFrom[status](""), nil, enumErrType},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestEnum_Set(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	enumErrType := reflect.TypeOf(null.EnumError{})
+
+	cases := []struct {
+		nullable null.Enum[status]
+		string   string
+		valid    bool
+		errType  reflect.Type
+	}{
+		{null.Enum[status]{}, "active", true, nilType},
+		{null.Enum[status]{}, "", false, nilType},
+		{null.Enum[status]{}, "deleted", false, enumErrType},
+		{null.Enum[status]{}, "Active", false, enumErrType},
+	}
+
+	for n, c := range cases {
+		err := c.nullable.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != c.nullable.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, c.nullable.Valid,
+			)
+		}
+		if c.nullable.Valid && status(c.string) != c.nullable.Val {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %s, got %s)",
+				t.Name(), n+1, c.string, c.nullable.Val,
+			)
+		}
+	}
+
+	e := null.Enum[status]{}
+	err := e.Set("deleted").(null.EnumError)
+	exp := []string{"active", "suspended"}
+	if !reflect.DeepEqual(exp, err.AllowedValues) || err.DestType != "status" {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+}
+
+func TestEnum_UnmarshalText(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	enumErrType := reflect.TypeOf(null.EnumError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("suspended"), true, nilType},
+		{nil, false, nilType},
+		{[]byte("deleted"), false, enumErrType},
+	}
+
+	for n, c := range cases {
+		e := null.Enum[status]{}
+		err := e.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != e.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, e.Valid,
+			)
+		}
+	}
+}
+
+func TestEnum_UnmarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	enumErrType := reflect.TypeOf(null.EnumError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`"active"`), true, nilType},
+		{[]byte("null"), false, nilType},
+		{[]byte(`"deleted"`), false, enumErrType},
+		{[]byte(`""`), false, enumErrType},
+		{[]byte("1"), false, typeErrType},
+		{[]byte(`"active`), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		e := null.Enum[status]{}
+		err := e.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != e.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, e.Valid,
+			)
+		}
+	}
+}
+
+func TestEnum_Scan(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	enumErrType := reflect.TypeOf(null.EnumError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{"active", true, nilType},
+		{[]byte("suspended"), true, nilType},
+		{nil, false, nilType},
+		{"deleted", false, enumErrType},
+		{int64(1), false, typeErrType},
+	}
+
+	for n, c := range cases {
+		e := null.Enum[status]{}
+		err := e.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != e.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, e.Valid,
+			)
+		}
+	}
+}
@@ -6,6 +6,19 @@ import (
 	"strings"
 )
 
+// typeName returns the name of v's type. Unnamed types, such as []byte, are
+// described by their literal, and "nil" is returned if v is nil.
+func typeName(v interface{}) string {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return "nil"
+	}
+	if t.Name() != "" {
+		return t.Name()
+	}
+	return t.String()
+}
+
 // MarshalError is returned if marshaling is not successful.
 type MarshalError struct {
 	// prefix indicates the error class
@@ -23,7 +36,7 @@ func makeMarshalError(prefix string, src interface{}) error {
 	return MarshalError{
 		prefix:   prefix,
 		SrcValue: src,
-		SrcType:  reflect.TypeOf(src).Name(),
+		SrcType:  typeName(src),
 	}
 }
 
@@ -52,7 +65,7 @@ func makeUnmarshalError(prefix string, src []byte, dest interface{}) error {
 	return UnmarshalError{
 		prefix:   prefix,
 		SrcValue: string(src),
-		DestType: reflect.TypeOf(dest).Name(),
+		DestType: typeName(dest),
 	}
 }
 
@@ -85,8 +98,8 @@ func makeConversionError(prefix string, src, dest interface{}) error {
 	return ConversionError{
 		prefix:   prefix,
 		SrcValue: src,
-		SrcType:  reflect.TypeOf(src).Name(),
-		DestType: reflect.TypeOf(dest).Name(),
+		SrcType:  typeName(src),
+		DestType: typeName(dest),
 	}
 }
 
@@ -115,7 +128,7 @@ func makeParseError(prefix, src string, dest interface{}) error {
 	return ParseError{
 		prefix:    prefix,
 		SrcString: src,
-		DestType:  reflect.TypeOf(dest).Name(),
+		DestType:  typeName(dest),
 	}
 }
 
@@ -143,7 +156,7 @@ type TypeError struct {
 func makeTypeError(prefix string, src interface{}, exp ...string) error {
 	return TypeError{
 		prefix:        prefix,
-		InvalidType:   reflect.TypeOf(src).Name(),
+		InvalidType:   typeName(src),
 		ExpectedTypes: exp,
 	}
 }
@@ -155,3 +168,73 @@ func (e TypeError) Error() string {
 		e.prefix, strings.Join(e.ExpectedTypes, " or "), e.InvalidType,
 	)
 }
+
+// DimensionError is returned when a multi-dimensional array literal is
+// parsed into a one-dimensional array type.
+type DimensionError struct {
+	// prefix indicates the error class
+	prefix string
+
+	// SrcString holds the array literal that couldn't be parsed
+	SrcString string
+
+	// Dimensions holds the number of dimensions of SrcString
+	Dimensions int
+
+	// DestType holds the destination type
+	DestType string
+}
+
+// helper function to build a DimensionError.
+func makeDimensionError(prefix, src string, dims int, dest interface{}) error {
+	return DimensionError{
+		prefix:     prefix,
+		SrcString:  src,
+		Dimensions: dims,
+		DestType:   typeName(dest),
+	}
+}
+
+// Error returns a string representation of e.
+func (e DimensionError) Error() string {
+	return fmt.Sprintf(
+		"%s: cannot parse %d-dimensional array '%s' into Go value of type %s",
+		e.prefix, e.Dimensions, e.SrcString, e.DestType,
+	)
+}
+
+// EnumError is returned when a value which is not one of the allowed values
+// of an enumeration is supplied.
+type EnumError struct {
+	// prefix indicates the error class
+	prefix string
+
+	// SrcValue holds the value that is not allowed
+	SrcValue string
+
+	// AllowedValues holds the allowed values
+	AllowedValues []string
+
+	// DestType holds the destination type
+	DestType string
+}
+
+// helper function to build an EnumError.
+func makeEnumError(
+	prefix, src string, allowed []string, dest interface{},
+) error {
+	return EnumError{
+		prefix:        prefix,
+		SrcValue:      src,
+		AllowedValues: allowed,
+		DestType:      typeName(dest),
+	}
+}
+
+// Error returns a string representation of e.
+func (e EnumError) Error() string {
+	return fmt.Sprintf(
+		"%s: '%s' is not an allowed value of type %s (expected one of %s)",
+		e.prefix, e.SrcValue, e.DestType, strings.Join(e.AllowedValues, ", "),
+	)
+}
@@ -154,3 +154,66 @@ func TestTypeError_Error(t *testing.T) {
 		}
 	}
 }
+
+func TestDimensionError_Error(t *testing.T) {
+	cases := []struct {
+		prefix string
+		src    string
+		dims   int
+		dest   interface{}
+	}{
+		{"test", "{{1}}", 2, 0},
+		{"test", "{{{a}}}", 3, ""},
+	}
+
+	for n, c := range cases {
+		err := makeDimensionError(
+			c.prefix, c.src, c.dims, c.dest,
+		).(DimensionError)
+
+		str := fmt.Sprintf(
+			"%s: cannot parse %d-dimensional array '%s' "+
+				"into Go value of type %s",
+			err.prefix, err.Dimensions, err.SrcString, err.DestType,
+		)
+
+		if str != err.Error() {
+			t.Fatalf(
+				"%s, case #%d: error message mismatch "+
+					"(expected '%s', got '%s')",
+				t.Name(), n+1, err.Error(), str,
+			)
+		}
+	}
+}
+
+func TestEnumError_Error(t *testing.T) {
+	cases := []struct {
+		prefix  string
+		src     string
+		allowed []string
+		dest    interface{}
+	}{
+		{"test", "c", []string{"a", "b"}, ""},
+		{"test", "3", []string{"1", "2"}, 0},
+	}
+
+	for n, c := range cases {
+		err := makeEnumError(c.prefix, c.src, c.allowed, c.dest).(EnumError)
+
+		str := fmt.Sprintf(
+			"%s: '%s' is not an allowed value of type %s "+
+				"(expected one of %s)",
+			err.prefix, err.SrcValue, err.DestType,
+			strings.Join(err.AllowedValues, ", "),
+		)
+
+		if str != err.Error() {
+			t.Fatalf(
+				"%s, case #%d: error message mismatch "+
+					"(expected '%s', got '%s')",
+				t.Name(), n+1, err.Error(), str,
+			)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// FieldState describes whether a Field is unset, set to an undefined value,
+// or set to a valid value.
+type FieldState int
+
+const (
+	// FieldUnset indicates that the value is missing, for example because
+	// the associated key was not present in the JSON input.
+	FieldUnset FieldState = iota
+
+	// FieldNull indicates that the value is present, but undefined.
+	FieldNull
+
+	// FieldSet indicates that the value is present and valid.
+	FieldSet
+)
+
+// Field implements a three-state wrapper around a nullable T, such as Int or
+// Of[int]. In addition to the validity of the underlying nullable,
+// Field records whether a value was supplied at all. This allows, for
+// instance, to tell a JSON key that was omitted from a key explicitly set to
+// null, which is needed to implement HTTP PATCH semantics.
+//
+// Field implements Nullable by delegating to Val, so that f is valid only if
+// it is FieldSet.
+//
+// Field should be used with the omitzero struct tag option, so that unset
+// fields are omitted from the JSON output:
+//
+//	var patch struct {
+//	    Name null.Field[null.String] `json:"name,omitzero"`
+//	}
+type Field[T any] struct {
+	// Val holds the underlying nullable value.
+	Val T
+
+	// Present holds the presence flag. If true, a value was supplied,
+	// and the validity of Val tells whether it is defined. If false,
+	// no value was supplied, and Val is meaningless.
+	Present bool
+}
+
+// FieldFrom creates a present Field from v.
+func FieldFrom[T any](v T) Field[T] {
+	return Field[T]{
+		Val:     v,
+		Present: true,
+	}
+}
+
+// State returns the state of f. If f is present, the validity of the
+// underlying nullable decides whether f is FieldNull or FieldSet.
+func (f Field[T]) State() FieldState {
+	if !f.Present {
+		return FieldUnset
+	}
+	if isNull(f.Val) {
+		return FieldNull
+	}
+	return FieldSet
+}
+
+// IsZero reports whether f is unset. It is used by json.Marshal to omit
+// unset fields tagged with omitzero.
+func (f Field[T]) IsZero() bool {
+	return !f.Present
+}
+
+// From sets the underlying value of f to v. f becomes present.
+func (f *Field[T]) From(v T) {
+	f.Present = true
+	f.Val = v
+}
+
+// Unset marks f as not present.
+func (f *Field[T]) Unset() {
+	var zero T
+	f.Present = false
+	f.Val = zero
+}
+
+// IsValid returns true if the state of f is FieldSet.
+func (f Field[T]) IsValid() bool {
+	return f.State() == FieldSet
+}
+
+// Invalidate makes f invalid. If T implements Nullable, f becomes present
+// and its underlying value is invalidated, so that f is FieldNull,
+// otherwise f is unset.
+func (f *Field[T]) Invalidate() {
+	if n, ok := interface{}(&f.Val).(Nullable); ok {
+		f.Present = true
+		n.Invalidate()
+		return
+	}
+	f.Unset()
+}
+
+// Interface returns the underlying value of the underlying nullable of f
+// if f is valid, otherwise nil. If T does not implement Nullable,
+// the underlying value of f is returned as T.
+func (f Field[T]) Interface() interface{} {
+	if !f.IsValid() {
+		return nil
+	}
+	if n, ok := interface{}(&f.Val).(Nullable); ok {
+		return n.Interface()
+	}
+	return f.Val
+}
+
+// SetInterface passes v to the SetInterface method of the underlying value
+// of f if T implements Nullable, and f becomes present. Otherwise, it unsets
+// f if v is nil, or sets the underlying value of f to v if v's type is T.
+// If v's type is any other type, f becomes invalid, and a TypeError is
+// returned.
+func (f *Field[T]) SetInterface(v interface{}) error {
+	if n, ok := interface{}(&f.Val).(Nullable); ok {
+		f.Present = true
+		return n.SetInterface(v)
+	}
+	switch value := v.(type) {
+	case T:
+		f.From(value)
+		return nil
+	case nil:
+		f.Unset()
+		return nil
+	default:
+		f.Unset()
+		return makeTypeError("set", value, typeName(f.Val), "nil")
+	}
+}
+
+// String returns a string representation of f. If f is present,
+// it returns the underlying value of f formatted with fmt.Sprint,
+// otherwise it returns InvalidNullableString.
+func (f Field[T]) String() string {
+	if f.Present {
+		return fmt.Sprint(f.Val)
+	}
+	return InvalidNullableString
+}
+
+// MarshalJSON encodes the underlying value of f with json.Marshal if f is
+// present, otherwise it returns the JSON null value. If the underlying value
+// of f cannot be marshaled, a MarshalError is returned.
+func (f Field[T]) MarshalJSON() (data []byte, err error) {
+	if f.Present {
+		bytes, err := json.Marshal(f.Val)
+		if err != nil {
+			return nil, makeMarshalError("json", f)
+		}
+		return bytes, nil
+	}
+	return jNull, nil
+}
+
+// Value returns the driver.Value of the underlying value of f if f is
+// present, otherwise nil. An undefined underlying value results in nil.
+// Errors returned by the underlying value are passed through.
+func (f Field[T]) Value() (v driver.Value, err error) {
+	if !f.Present {
+		return nil, nil
+	}
+	if valuer, ok := interface{}(f.Val).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	val, err := driver.DefaultParameterConverter.ConvertValue(f.Val)
+	if err != nil {
+		return nil, makeMarshalError("sql", f)
+	}
+	return val, nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to f.
+// f becomes present, and data is unmarshaled into the underlying value of f,
+// so that the JSON null value results in FieldNull. Errors returned by the
+// underlying value are passed through. If T does not implement
+// json.Unmarshaler and data cannot be unmarshaled into a T,
+// an UnmarshalError is returned.
+func (f *Field[T]) UnmarshalJSON(data []byte) error {
+	f.Present = true
+	if u, ok := interface{}(&f.Val).(json.Unmarshaler); ok {
+		return u.UnmarshalJSON(data)
+	}
+	if json.Unmarshal(data, &f.Val) != nil {
+		return makeUnmarshalError("json", data, *f)
+	}
+	return nil
+}
+
+// Scan assigns a value from a database driver. f becomes present, and obj is
+// scanned into the underlying value of f, so that SQL NULL results in
+// FieldNull. Errors returned by the underlying value are passed through.
+func (f *Field[T]) Scan(obj interface{}) error {
+	f.Present = true
+	if s, ok := interface{}(&f.Val).(sql.Scanner); ok {
+		return s.Scan(obj)
+	}
+	if obj == nil {
+		var zero T
+		f.Val = zero
+		return nil
+	}
+	return assignValue("sql", reflect.ValueOf(&f.Val).Elem(), obj)
+}
+
+// isNull reports whether v holds an undefined value. v is undefined if it
+// is nil or a nil pointer, if it is a Nullable that is not valid, or if it
+// implements driver.Valuer and its Value method returns nil without error.
+func isNull(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return true
+	}
+	if n, ok := v.(interface{ IsValid() bool }); ok {
+		return !n.IsValid()
+	}
+	if valuer, ok := v.(driver.Valuer); ok {
+		val, err := valuer.Value()
+		return val == nil && err == nil
+	}
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
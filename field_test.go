@@ -0,0 +1,226 @@
+package null_test
+
+import (
+	"encoding/json"
+	"null"
+	"reflect"
+	"testing"
+)
+
+func TestFieldFrom(t *testing.T) {
+	cases := []struct {
+		nullable null.Int
+		state    null.FieldState
+	}{
+		{null.IntFrom(0), null.FieldSet},
+		{null.IntFrom(1), null.FieldSet},
+		{null.Int{}, null.FieldNull},
+	}
+
+	for n, c := range cases {
+		f := null.FieldFrom(c.nullable)
+		if !f.Present {
+			t.Fatalf("%s, case #%d: field not present", t.Name(), n+1)
+		}
+		if c.state != f.State() {
+			t.Fatalf(
+				"%s, case #%d: state mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.state, f.State(),
+			)
+		}
+	}
+}
+
+func TestField_State(t *testing.T) {
+	cases := []struct {
+		state func() null.FieldState
+		exp   null.FieldState
+	}{
+		{null.Field[null.String]{}.State, null.FieldUnset},
+		{null.Field[null.String]{
+			Val: null.StringFrom("foo"),
+		}.State, null.FieldUnset},
+		{null.Field[null.String]{Present: true}.State, null.FieldNull},
+		{null.Field[null.String]{
+			Val: null.StringFrom(""), Present: true,
+		}.State, null.FieldSet},
+		{null.Field[null.Of[int]]{Present: true}.State, null.FieldNull},
+		{null.Field[*int]{Present: true}.State, null.FieldNull},
+		{null.Field[*int]{Val: intp(1), Present: true}.State, null.FieldSet},
+	}
+
+	for n, c := range cases {
+		if c.exp != c.state() {
+			t.Fatalf(
+				"%s, case #%d: state mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.exp, c.state(),
+			)
+		}
+	}
+}
+
+func TestField_Unset(t *testing.T) {
+	f := null.FieldFrom(null.IntFrom(1))
+	f.Unset()
+	if f.State() != null.FieldUnset || f.Val.Valid {
+		t.Fatalf("%s: field not unset", t.Name())
+	}
+
+	f.From(null.IntFrom(2))
+	if f.State() != null.FieldSet || f.Val.Int != 2 {
+		t.Fatalf("%s: field not set", t.Name())
+	}
+}
+
+func TestField_MarshalJSON(t *testing.T) {
+	type patch struct {
+		Name null.Field[null.String] `json:"name,omitzero"`
+		Age  null.Field[null.Int]    `json:"age,omitzero"`
+	}
+
+	cases := []struct {
+		patch patch
+		json  string
+	}{
+		{patch{}, `{}`},
+		{patch{Name: null.FieldFrom(null.String{})}, `{"name":null}`},
+		{
+			patch{
+				Name: null.FieldFrom(null.StringFrom("foo")),
+				Age:  null.FieldFrom(null.IntFrom(3)),
+			},
+			`{"name":"foo","age":3}`,
+		},
+	}
+
+	for n, c := range cases {
+		data, err := json.Marshal(c.patch)
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if c.json != string(data) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.json, string(data),
+			)
+		}
+	}
+
+	data, err := null.Field[null.Int]{}.MarshalJSON()
+	if err != nil || string(data) != "null" {
+		t.Fatalf(
+			"%s: json mismatch (expected 'null', got '%s')",
+			t.Name(), string(data),
+		)
+	}
+}
+
+func TestField_UnmarshalJSON(t *testing.T) {
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    string
+		state   null.FieldState
+		literal int
+	}{
+		{`{}`, null.FieldUnset, 0},
+		{`{"age":null}`, null.FieldNull, 0},
+		{`{"age":7}`, null.FieldSet, 7},
+	}
+
+	for n, c := range cases {
+		var patch struct {
+			Age null.Field[null.Int] `json:"age"`
+		}
+		if err := json.Unmarshal([]byte(c.json), &patch); err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if c.state != patch.Age.State() {
+			t.Fatalf(
+				"%s, case #%d: state mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.state, patch.Age.State(),
+			)
+		}
+		if c.literal != patch.Age.Val.Int {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, patch.Age.Val.Int,
+			)
+		}
+	}
+
+	var f null.Field[null.Int]
+	err := f.UnmarshalJSON([]byte(`"x"`))
+	if typeErrType != reflect.TypeOf(err) {
+		t.Fatalf(
+			"%s: wrong error type (expected %v, got %v)",
+			t.Name(), typeErrType, reflect.TypeOf(err),
+		)
+	}
+
+	var p null.Field[*int]
+	err = p.UnmarshalJSON([]byte(`"x"`))
+	if unmarshalErrType != reflect.TypeOf(err) {
+		t.Fatalf(
+			"%s: wrong error type (expected %v, got %v)",
+			t.Name(), unmarshalErrType, reflect.TypeOf(err),
+		)
+	}
+}
+
+func TestField_Value(t *testing.T) {
+	cases := []struct {
+		field null.Field[null.Int]
+		value interface{}
+	}{
+		{null.Field[null.Int]{}, nil},
+		{null.FieldFrom(null.Int{}), nil},
+		{null.FieldFrom(null.IntFrom(4)), int64(4)},
+	}
+
+	for n, c := range cases {
+		v, err := c.field.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, v) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestField_Scan(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		state   null.FieldState
+		errType reflect.Type
+	}{
+		{nil, null.FieldNull, nilType},
+		{int64(1), null.FieldSet, nilType},
+		{"x", null.FieldNull, typeErrType},
+	}
+
+	for n, c := range cases {
+		var f null.Field[null.Int]
+		err := f.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.state != f.State() {
+			t.Fatalf(
+				"%s, case #%d: state mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.state, f.State(),
+			)
+		}
+	}
+}
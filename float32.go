@@ -0,0 +1,248 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+// Float32 implements a nullable float32.
+type Float32 struct {
+	// Float32 holds the underlying float32 value.
+	Float32 float32
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// Float32From creates a valid Float32 from v.
+func Float32From(v float32) Float32 {
+	return Float32FromPtr(&v)
+}
+
+// Float32FromPtr creates a Float32 from pointer p. If p is nil,
+// the returned Float32 is invalid.
+func Float32FromPtr(p *float32) Float32 {
+	if p != nil {
+		return Float32{
+			Float32: *p,
+			Valid:   true,
+		}
+	}
+	return Float32{}
+}
+
+// Float32FromZero creates a Float32 from v. If v is 0,
+// the returned Float32 is invalid.
+func Float32FromZero(v float32) Float32 {
+	return Float32{
+		Float32: v,
+		Valid:   v != 0.0,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of f if f is valid,
+// otherwise returns nil.
+func (f Float32) Ptr() *float32 {
+	if f.Valid {
+		return &f.Float32
+	}
+	return nil
+}
+
+// Zero returns the underlying value of f if f is valid, otherwise
+// returns 0.
+func (f Float32) Zero() float32 {
+	if f.Valid {
+		return f.Float32
+	}
+	return 0.0
+}
+
+// From sets the underlying value of f to v. f becomes valid.
+func (f *Float32) From(v float32) {
+	f.Valid = true
+	f.Float32 = v
+}
+
+// FromPtr invalidates f if p is nil, otherwise it sets the underlying value
+// of f to the value pointed to by p, and f becomes valid.
+func (f *Float32) FromPtr(p *float32) {
+	f.Valid = p != nil
+	if p != nil {
+		f.Float32 = *p
+	}
+}
+
+// FromZero invalidates f if v is 0,
+// otherwise it sets the underlying value of f to v, and f becomes valid.
+func (f *Float32) FromZero(v float32) {
+	f.Valid = v != 0.0
+	f.Float32 = v
+}
+
+// IsValid returns true if f is valid.
+func (f Float32) IsValid() bool {
+	return f.Valid
+}
+
+// Invalidate makes f invalid.
+func (f *Float32) Invalidate() {
+	f.Valid = false
+}
+
+// Interface returns the underlying value of f as float32 if f is valid,
+// otherwise nil.
+func (f Float32) Interface() interface{} {
+	if f.Valid {
+		return f.Float32
+	}
+	return nil
+}
+
+// SetInterface invalidates f if v is nil, otherwise if v's type is float32,
+// it sets the underlying value of f to v, and f becomes valid.
+// If v's type is any other type, f becomes invalid, and a TypeError is
+// returned.
+func (f *Float32) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case float32:
+		f.Float32 = value
+		f.Valid = true
+		return nil
+	case nil:
+		f.Valid = false
+		return nil
+	default:
+		f.Valid = false
+		return makeTypeError("set", value, "float32", "nil")
+	}
+}
+
+// String returns a string representation of f.
+// If f is valid, it returns the shortest string representation that
+// uniquely identifies the underlying value of f as a float32,
+// otherwise it returns InvalidNullableString.
+func (f Float32) String() string {
+	if f.Valid {
+		return strconv.FormatFloat(float64(f.Float32), 'g', -1, 32)
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals f to a byte string representation.
+// If f is valid, it marshals the underlying value of f to a byte string
+// representation, otherwise it returns nil. err is always nil.
+func (f Float32) MarshalText() (data []byte, err error) {
+	if f.Valid {
+		return []byte(f.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of f to a JSON number if f is
+// valid, otherwise it returns the JSON null value. The JSON number is the
+// shortest representation of the underlying value of f as a float32,
+// so that values such as 0.1 are not printed with spurious digits.
+// err is always nil.
+func (f Float32) MarshalJSON() (data []byte, err error) {
+	if f.Valid {
+		return []byte(f.String()), nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of f converted to float64 if f is
+// valid, otherwise nil. The conversion is exact. err is always nil.
+func (f Float32) Value() (v driver.Value, err error) {
+	if f.Valid {
+		return float64(f.Float32), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates f if str is the empty string, otherwise it parses str into
+// the underlying value of f, and f becomes valid. If str is not a valid
+// string representation of a floating point number, or if the represented
+// number is too large to be stored in a float32, f becomes invalid and a
+// ParseError is returned.
+func (f *Float32) Set(str string) error {
+	val, err := strconv.ParseFloat(str, 32)
+	f.Float32 = float32(val)
+	f.Valid = err == nil && str != ""
+	if str == "" || err == nil {
+		return nil
+	}
+
+	return makeParseError("parse", str, f.Float32)
+}
+
+// UnmarshalText unmarshals from a byte string to f.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (f *Float32) UnmarshalText(text []byte) error {
+	if f.Set(string(text)) != nil {
+		return makeUnmarshalError("parse", text, *f)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to f.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, f becomes invalid. If the encoded JSON data
+// represent a JSON number, and its magnitude does not exceed the largest
+// float32, f becomes valid, and the underlying value of f is set to the JSON
+// number rounded to the nearest float32. If the magnitude of the JSON number
+// exceeds the largest float32, f becomes invalid, and a ConversionError is
+// returned. Other JSON types produce a TypeError. Malformed JSON produces
+// an UnmarshalError.
+func (f *Float32) UnmarshalJSON(data []byte) error {
+	obj, ok := decodeJSONNumber(data)
+	if !ok {
+		f.Valid = false
+		return makeUnmarshalError("json", data, *f)
+	}
+	switch value := obj.(type) {
+	case json.Number:
+		val, err := strconv.ParseFloat(string(value), 32)
+		f.Float32 = float32(val)
+		f.Valid = err == nil
+		if !f.Valid {
+			return makeConversionError("json", value, f.Float32)
+		}
+		return nil
+	case nil:
+		f.Valid = false
+		return nil
+	default:
+		f.Valid = false
+		return makeTypeError("json", value, "json.Number", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is float64,
+// and its magnitude does not exceed the largest float32, f becomes valid,
+// and the underlying value of f becomes the value of obj rounded to the
+// nearest float32. If obj's type is float64, and its magnitude exceeds the
+// largest float32, f becomes invalid, and a ConversionError is returned.
+// If obj is nil, f becomes invalid. If obj's type is any other type,
+// f becomes invalid, and a TypeError is returned.
+func (f *Float32) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case float64:
+		f.Float32 = float32(value)
+		f.Valid = !math.IsInf(float64(f.Float32), 0) || math.IsInf(value, 0)
+		if !f.Valid {
+			return makeConversionError("sql", value, f.Float32)
+		}
+		return nil
+	case nil:
+		f.Valid = false
+		return nil
+	default:
+		f.Valid = false
+		return makeTypeError("sql", value, "float64", "nil")
+	}
+}
@@ -0,0 +1,673 @@
+package null_test
+
+import (
+	"math"
+	"null"
+	"reflect"
+	"testing"
+)
+
+func float32p(v float32) *float32 {
+	return &v
+}
+
+func TestFloat32From(t *testing.T) {
+	cases := []struct {
+		literal float32
+		valid   bool
+	}{
+		{0.0, true},
+		{3.0, true},
+		{0.23, true},
+		{-1e+10, true},
+	}
+
+	for n, c := range cases {
+		f := null.Float32From(c.literal)
+		if c.valid != f.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, f.Valid,
+			)
+		}
+		if !f.Valid {
+			continue
+		}
+
+		if c.literal != f.Float32 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %g, got %g)",
+				t.Name(), n+1, c.literal, f.Float32,
+			)
+		}
+	}
+}
+
+func TestFloat32FromPtr(t *testing.T) {
+	cases := []struct {
+		ptr   *float32
+		valid bool
+	}{
+		{float32p(0.0), true},
+		{float32p(3.0), true},
+		{float32p(0.23), true},
+		{float32p(-1e+10), true},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		f := null.Float32FromPtr(c.ptr)
+		if c.valid != f.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, f.Valid,
+			)
+		}
+		if !f.Valid {
+			continue
+		}
+
+		if *c.ptr != f.Float32 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %g, got %g)",
+				t.Name(), n+1, *c.ptr, f.Float32,
+			)
+		}
+	}
+}
+
+func TestFloat32FromZero(t *testing.T) {
+	cases := []struct {
+		literal float32
+		valid   bool
+	}{
+		{0.0, false},
+		{3.0, true},
+		{0.23, true},
+		{-1e+10, true},
+	}
+
+	for n, c := range cases {
+		f := null.Float32FromZero(c.literal)
+		if c.valid != f.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, f.Valid,
+			)
+		}
+		if !f.Valid {
+			continue
+		}
+
+		if c.literal != f.Float32 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %g, got %g)",
+				t.Name(), n+1, c.literal, f.Float32,
+			)
+		}
+	}
+}
+
+func TestFloat32_Ptr(t *testing.T) {
+	cases := []struct {
+		nullable null.Float32
+	}{
+		{null.Float32{Float32: 0.0, Valid: true}},
+		{null.Float32{Float32: 3.0, Valid: true}},
+		{null.Float32{Float32: 0.23, Valid: true}},
+		{null.Float32{Float32: -1e+10, Valid: true}},
+		{null.Float32{}},
+		{null.Float32{Float32: 12.34, Valid: false}},
+	}
+
+	for n, c := range cases {
+		p := c.nullable.Ptr()
+		if c.nullable.Valid {
+			if p == nil {
+				t.Fatalf(
+					"%s, case #%d: nil pointer returned",
+					t.Name(), n+1,
+				)
+			}
+			if c.nullable.Float32 != *p {
+				t.Fatalf(
+					"%s, case #%d: literal mismatch (expected %g, got %g)",
+					t.Name(), n+1, c.nullable.Float32, *p,
+				)
+			}
+		} else {
+			if p != nil {
+				t.Fatalf(
+					"%s, case #%d: non-nil pointer returned",
+					t.Name(), n+1,
+				)
+			}
+		}
+	}
+}
+
+func TestFloat32_Zero(t *testing.T) {
+	cases := []struct {
+		nullable null.Float32
+	}{
+		{null.Float32{Float32: 0.0, Valid: true}},
+		{null.Float32{Float32: 3.0, Valid: true}},
+		{null.Float32{Float32: 0.23, Valid: true}},
+		{null.Float32{Float32: -1e+10, Valid: true}},
+		{null.Float32{}},
+		{null.Float32{Float32: 12.34, Valid: false}},
+	}
+
+	for n, c := range cases {
+		f := c.nullable.Zero()
+		if c.nullable.Valid {
+			if c.nullable.Float32 != f {
+				t.Fatalf(
+					"%s, case #%d: literal mismatch (expected %g, got %g)",
+					t.Name(), n+1, c.nullable.Float32, f,
+				)
+			}
+		} else {
+			if f != 0.0 {
+				t.Fatalf(
+					"%s, case #%d: non-zero uint returned",
+					t.Name(), n+1,
+				)
+			}
+		}
+	}
+}
+
+func TestFloat32_From(t *testing.T) {
+	var f null.Float32
+	cases := []struct {
+		literal float32
+		valid   bool
+	}{
+		{0.0, true},
+		{3.0, true},
+		{0.23, true},
+		{-1e+10, true},
+	}
+
+	for n, c := range cases {
+		f.From(c.literal)
+		if c.valid != f.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, f.Valid,
+			)
+		}
+		if !f.Valid {
+			continue
+		}
+
+		if c.literal != f.Float32 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %g, got %g)",
+				t.Name(), n+1, c.literal, f.Float32,
+			)
+		}
+	}
+}
+
+func TestFloat32_FromPtr(t *testing.T) {
+	var f null.Float32
+	cases := []struct {
+		ptr   *float32
+		valid bool
+	}{
+		{float32p(0.0), true},
+		{float32p(3.0), true},
+		{float32p(0.23), true},
+		{float32p(-1e+10), true},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		f.FromPtr(c.ptr)
+		if c.valid != f.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, f.Valid,
+			)
+		}
+		if !f.Valid {
+			continue
+		}
+
+		if *c.ptr != f.Float32 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %g, got %g)",
+				t.Name(), n+1, *c.ptr, f.Float32,
+			)
+		}
+	}
+}
+
+func TestFloat32_FromZero(t *testing.T) {
+	var f null.Float32
+	cases := []struct {
+		literal float32
+		valid   bool
+	}{
+		{0.0, false},
+		{3.0, true},
+		{0.23, true},
+		{-1e+10, true},
+	}
+
+	for n, c := range cases {
+		f.FromZero(c.literal)
+		if c.valid != f.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, f.Valid,
+			)
+		}
+		if !f.Valid {
+			continue
+		}
+
+		if c.literal != f.Float32 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %g, got %g)",
+				t.Name(), n+1, c.literal, f.Float32,
+			)
+		}
+	}
+}
+
+func TestFloat32_String(t *testing.T) {
+	cases := []struct {
+		nullable null.Float32
+		string   string
+	}{
+		{null.Float32{Float32: 0.0, Valid: true}, "0"},
+		{null.Float32{Float32: 3.0, Valid: true}, "3"},
+		{null.Float32{Float32: 0.23, Valid: true}, "0.23"},
+		{null.Float32{Float32: -1e+10, Valid: true}, "-1e+10"},
+		{null.Float32{Float32: 0.1, Valid: true}, "0.1"},
+		{null.Float32{Float32: 16777217, Valid: true}, "1.6777216e+07"},
+		{null.Float32{}, "<invalid>"},
+		{null.Float32{Float32: 12.34, Valid: false}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestFloat32_MarshalText(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+
+	cases := []struct {
+		nullable null.Float32
+		bytes    []byte
+		errType  reflect.Type
+	}{
+		{
+			null.Float32{Float32: 0.0, Valid: true},
+			[]byte("0"), nilType,
+		},
+		{
+			null.Float32{Float32: 3.0, Valid: true},
+			[]byte("3"), nilType,
+		},
+		{
+			null.Float32{Float32: 0.23, Valid: true},
+			[]byte("0.23"), nilType,
+		},
+		{
+			null.Float32{Float32: -1e+10, Valid: true},
+			[]byte("-1e+10"), nilType,
+		},
+		{
+			null.Float32{},
+			nil, nilType,
+		},
+		{
+			null.Float32{Float32: 12.34, Valid: false},
+			nil, nilType,
+		},
+	}
+
+	for n, c := range cases {
+		f, err := c.nullable.MarshalText()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if !reflect.DeepEqual(c.bytes, f) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(f),
+			)
+		}
+	}
+}
+
+func TestFloat32_MarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+
+	cases := []struct {
+		nullable null.Float32
+		json     []byte
+		errType  reflect.Type
+	}{
+		{
+			null.Float32{Float32: 0.0, Valid: true},
+			[]byte("0"), nilType,
+		},
+		{
+			null.Float32{Float32: 3.0, Valid: true},
+			[]byte("3"), nilType,
+		},
+		{
+			null.Float32{Float32: 0.23, Valid: true},
+			[]byte("0.23"), nilType,
+		},
+		{
+			null.Float32{Float32: -1e+10, Valid: true},
+			[]byte("-1e+10"), nilType,
+		},
+		{
+			null.Float32{},
+			[]byte("null"), nilType,
+		},
+		{
+			null.Float32{Float32: 12.34, Valid: false},
+			[]byte("null"), nilType,
+		},
+	}
+
+	for n, c := range cases {
+		f, err := c.nullable.MarshalJSON()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if !reflect.DeepEqual(c.json, f) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(f),
+			)
+		}
+	}
+}
+
+func TestFloat32_Value(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	f64Type := reflect.TypeOf(float64(0.0))
+
+	cases := []struct {
+		nullable null.Float32
+		expType  reflect.Type
+		errType  reflect.Type
+	}{
+		{null.Float32{Float32: 0.0, Valid: true}, f64Type, nilType},
+		{null.Float32{Float32: 3.0, Valid: true}, f64Type, nilType},
+		{null.Float32{Float32: 0.23, Valid: true}, f64Type, nilType},
+		{null.Float32{Float32: -1e+10, Valid: true}, f64Type, nilType},
+		{null.Float32{}, nilType, nilType},
+		{null.Float32{Float32: 12.34, Valid: false}, nilType, nilType},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.expType != reflect.TypeOf(v) {
+			t.Fatalf(
+				"%s, case #%d: type mismatch (expected %s, got %s)",
+				t.Name(), n+1, c.expType, reflect.TypeOf(v),
+			)
+		}
+		if v == nil {
+			continue
+		}
+
+		if float64(c.nullable.Float32) != reflect.ValueOf(v).Float() {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %g, got %v)",
+				t.Name(), n+1, c.nullable.Float32, v,
+			)
+		}
+	}
+}
+
+func TestFloat32_Set(t *testing.T) {
+	var f null.Float32
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		literal float32
+		valid   bool
+		errType reflect.Type
+	}{
+		{"0", 0, true, nilType},
+		{"3", 3, true, nilType},
+		{"0.23", 0.23, true, nilType},
+		{"-1e+10", -1e+10, true, nilType},
+		{"", 0, false, nilType},
+		{"x", 0, false, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := f.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.valid != f.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, f.Valid,
+			)
+		}
+		if !f.Valid {
+			continue
+		}
+
+		if c.literal != f.Float32 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %g, got %g)",
+				t.Name(), n+1, c.literal, f.Float32,
+			)
+		}
+	}
+}
+
+func TestFloat32_UnmarshalText(t *testing.T) {
+	var f null.Float32
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		literal float32
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("0"), 0, true, nilType},
+		{[]byte("3"), 3, true, nilType},
+		{[]byte("0.23"), 0.23, true, nilType},
+		{[]byte("-1e+10"), -1e+10, true, nilType},
+		{nil, 0, false, nilType},
+		{[]byte(""), 0, false, nilType},
+		{[]byte("x"), 0, false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := f.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.valid != f.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, f.Valid,
+			)
+		}
+		if !f.Valid {
+			continue
+		}
+
+		if c.literal != f.Float32 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %g, got %g)",
+				t.Name(), n+1, c.literal, f.Float32,
+			)
+		}
+	}
+}
+
+func TestFloat32_UnmarshalJSON(t *testing.T) {
+	var f null.Float32
+	nilType := reflect.TypeOf(nil)
+	cnvErrType := reflect.TypeOf(null.ConversionError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		literal float32
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("0"), 0, true, nilType},
+		{[]byte("3"), 3, true, nilType},
+		{[]byte("0.23"), 0.23, true, nilType},
+		{[]byte("-10000000000"), -1e+10, true, nilType},
+		{[]byte("0.1"), 0.1, true, nilType},
+		{[]byte("null"), 0, false, nilType},
+		{[]byte("1e39"), 0, false, cnvErrType},
+		{[]byte("-1e39"), 0, false, cnvErrType},
+		{[]byte(`"x"`), 0, false, typeErrType},
+		{nil, 0, false, unmarshalErrType},
+		{[]byte("x"), 0, false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := f.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.valid != f.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, f.Valid,
+			)
+		}
+		if !f.Valid {
+			continue
+		}
+
+		if c.literal != f.Float32 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %g, got %g)",
+				t.Name(), n+1, c.literal, f.Float32,
+			)
+		}
+	}
+}
+
+func TestFloat32_Scan(t *testing.T) {
+	var f null.Float32
+	nilType := reflect.TypeOf(nil)
+	cnvErrType := reflect.TypeOf(null.ConversionError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{float64(0.0), true, nilType},
+		{float64(3.0), true, nilType},
+		{float64(0.23), true, nilType},
+		{float64(-1e+10), true, nilType},
+		{math.Inf(1), true, nilType},
+		{nil, false, nilType},
+		{float64(1e39), false, cnvErrType},
+		{float32(0.23), false, typeErrType},
+		{"x", false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := f.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.valid != f.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, f.Valid,
+			)
+		}
+		if !f.Valid {
+			continue
+		}
+
+		if float32(c.source.(float64)) != f.Float32 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %g, got %g)",
+				t.Name(), n+1, c.source, f.Float32,
+			)
+		}
+	}
+}
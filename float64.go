@@ -82,6 +82,44 @@ func (f *Float64) FromZero(v float64) {
 	f.Float64 = v
 }
 
+// IsValid returns true if f is valid.
+func (f Float64) IsValid() bool {
+	return f.Valid
+}
+
+// Invalidate makes f invalid.
+func (f *Float64) Invalidate() {
+	f.Valid = false
+}
+
+// Interface returns the underlying value of f as float64 if f is valid,
+// otherwise nil.
+func (f Float64) Interface() interface{} {
+	if f.Valid {
+		return f.Float64
+	}
+	return nil
+}
+
+// SetInterface invalidates f if v is nil, otherwise if v's type is float64,
+// it sets the underlying value of f to v, and f becomes valid.
+// If v's type is any other type, f becomes invalid, and a TypeError is
+// returned.
+func (f *Float64) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case float64:
+		f.Float64 = value
+		f.Valid = true
+		return nil
+	case nil:
+		f.Valid = false
+		return nil
+	default:
+		f.Valid = false
+		return makeTypeError("set", value, "float64", "nil")
+	}
+}
+
 // String returns a string representation of f.
 // If f is valid, it returns a string representation of the underlying value
 // of f, otherwise it returns InvalidNullableString.
@@ -0,0 +1,262 @@
+package null_test
+
+import (
+	"math"
+	"null"
+	"reflect"
+	"testing"
+)
+
+// equalFloat64s reports whether a and b hold the same elements, where NaN
+// elements are equal to each other.
+func equalFloat64s(a, b []null.Float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		nan := math.IsNaN(a[i].Float64) && math.IsNaN(b[i].Float64)
+		if a[i].Valid != b[i].Valid || !nan && a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFloat64Array_String(t *testing.T) {
+	cases := []struct {
+		nullable null.Float64Array
+		string   string
+	}{
+		{
+			null.Float64ArrayFrom([]null.Float64{null.Float64From(1.5), {}}),
+			"{1.5,NULL}",
+		},
+		{
+			null.Float64ArrayFrom([]null.Float64{
+				null.Float64From(math.NaN()),
+				null.Float64From(math.Inf(1)),
+				null.Float64From(math.Inf(-1)),
+				null.Float64From(1e300),
+			}),
+			"{NaN,Infinity,-Infinity,1e+300}",
+		},
+		{null.Float64ArrayFrom([]null.Float64{}), "{}"},
+		{null.Float64Array{}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestFloat64Array_MarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable null.Float64Array
+		json     []byte
+		errType  reflect.Type
+	}{
+		{
+			null.Float64ArrayFrom([]null.Float64{null.Float64From(0.5), {}}),
+			[]byte("[0.5,null]"),
+			nilType,
+		},
+		{
+			null.Float64ArrayFrom([]null.Float64{null.Float64From(math.NaN())}),
+			nil,
+			marshalErrType,
+		},
+		{null.Float64ArrayFrom(nil), []byte("[]"), nilType},
+		{null.Float64Array{}, []byte("null"), nilType},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestFloat64Array_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.Float64Array
+		value    interface{}
+	}{
+		{
+			null.Float64ArrayFrom([]null.Float64{null.Float64From(0.5), {}}),
+			"{0.5,NULL}",
+		},
+		{null.Float64Array{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestFloat64Array_Set(t *testing.T) {
+	var a null.Float64Array
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	dimErrType := reflect.TypeOf(null.DimensionError{})
+
+	cases := []struct {
+		string  string
+		valid   bool
+		value   []null.Float64
+		errType reflect.Type
+	}{
+		{
+			"{1.5,NULL,-2e3}",
+			true,
+			[]null.Float64{null.Float64From(1.5), {}, null.Float64From(-2000)},
+			nilType,
+		},
+		{
+			"{NaN,Infinity,-Infinity}",
+			true,
+			[]null.Float64{
+				null.Float64From(math.NaN()),
+				null.Float64From(math.Inf(1)),
+				null.Float64From(math.Inf(-1)),
+			},
+			nilType,
+		},
+		{"{a}", false, nil, parseErrType},
+		{"{}", true, []null.Float64{}, nilType},
+		{"", false, nil, nilType},
+		{"{NULL,}", false, nil, parseErrType},
+		{"{{NULL}}", false, nil, dimErrType},
+	}
+
+	for n, c := range cases {
+		err := a.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != a.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, a.Valid,
+			)
+		}
+		if a.Valid && !equalFloat64s(c.value, a.Array) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, a.Array,
+			)
+		}
+	}
+}
+
+func TestFloat64Array_UnmarshalJSON(t *testing.T) {
+	var a null.Float64Array
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		value   []null.Float64
+		errType reflect.Type
+	}{
+		{
+			[]byte("[0.5,null]"),
+			true,
+			[]null.Float64{null.Float64From(0.5), {}},
+			nilType,
+		},
+		{[]byte(`["a"]`), false, nil, unmarshalErrType},
+		{[]byte("[]"), true, []null.Float64{}, nilType},
+		{[]byte("null"), false, nil, nilType},
+		{[]byte(`[{}]`), false, nil, unmarshalErrType},
+		{[]byte("x"), false, nil, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := a.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != a.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, a.Valid,
+			)
+		}
+		if a.Valid && !reflect.DeepEqual(c.value, a.Array) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, a.Array,
+			)
+		}
+	}
+}
+
+func TestFloat64Array_Scan(t *testing.T) {
+	var a null.Float64Array
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{"{1.5,NULL}", true, nilType},
+		{[]byte("{NaN}"), true, nilType},
+		{"{1,a}", false, parseErrType},
+		{nil, false, nilType},
+		{"", false, parseErrType},
+		{int64(1), false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := a.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != a.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, a.Valid,
+			)
+		}
+	}
+}
@@ -82,6 +82,44 @@ func (i *Int) FromZero(v int) {
 	i.Int = v
 }
 
+// IsValid returns true if i is valid.
+func (i Int) IsValid() bool {
+	return i.Valid
+}
+
+// Invalidate makes i invalid.
+func (i *Int) Invalidate() {
+	i.Valid = false
+}
+
+// Interface returns the underlying value of i as int if i is valid,
+// otherwise nil.
+func (i Int) Interface() interface{} {
+	if i.Valid {
+		return i.Int
+	}
+	return nil
+}
+
+// SetInterface invalidates i if v is nil, otherwise if v's type is int,
+// it sets the underlying value of i to v, and i becomes valid.
+// If v's type is any other type, i becomes invalid, and a TypeError is
+// returned.
+func (i *Int) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case int:
+		i.Int = value
+		i.Valid = true
+		return nil
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		i.Valid = false
+		return makeTypeError("set", value, "int", "nil")
+	}
+}
+
 // String returns a string representation of i.
 // If i is valid, it returns a string representation of the underlying value
 // of i, otherwise it returns InvalidNullableString.
@@ -0,0 +1,728 @@
+package null_test
+
+import (
+	"null"
+	"reflect"
+	"testing"
+)
+
+func int16p(v int16) *int16 {
+	return &v
+}
+
+func TestInt16From(t *testing.T) {
+	cases := []struct {
+		literal int16
+		valid   bool
+	}{
+		{0, true},
+		{1, true},
+		{-1, true},
+	}
+
+	for n, c := range cases {
+		i := null.Int16From(c.literal)
+		if c.valid != i.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, i.Valid,
+			)
+		}
+		if !i.Valid {
+			continue
+		}
+
+		if c.literal != i.Int16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, i.Int16,
+			)
+		}
+	}
+}
+
+func TestInt16FromPtr(t *testing.T) {
+	cases := []struct {
+		ptr   *int16
+		valid bool
+	}{
+		{int16p(0), true},
+		{int16p(1), true},
+		{int16p(-1), true},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		i := null.Int16FromPtr(c.ptr)
+		if c.valid != i.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, i.Valid,
+			)
+		}
+		if !i.Valid {
+			continue
+		}
+
+		if *c.ptr != i.Int16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, *c.ptr, i.Int16,
+			)
+		}
+	}
+}
+
+func TestInt16FromZero(t *testing.T) {
+	cases := []struct {
+		literal int16
+		valid   bool
+	}{
+		{0, false},
+		{1, true},
+		{-1, true},
+	}
+
+	for n, c := range cases {
+		i := null.Int16FromZero(c.literal)
+		if c.valid != i.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, i.Valid,
+			)
+		}
+		if !i.Valid {
+			continue
+		}
+
+		if c.literal != i.Int16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, i.Int16,
+			)
+		}
+	}
+}
+
+func TestInt16_Ptr(t *testing.T) {
+	cases := []struct {
+		nullable null.Int16
+	}{
+		{null.Int16{Int16: 0, Valid: true}},
+		{null.Int16{Int16: 1, Valid: true}},
+		{null.Int16{Int16: -1, Valid: true}},
+		{null.Int16{}},
+		{null.Int16{Int16: 2, Valid: false}},
+		{null.Int16{Int16: -2, Valid: false}},
+	}
+
+	for n, c := range cases {
+		p := c.nullable.Ptr()
+		if c.nullable.Valid {
+			if p == nil {
+				t.Fatalf(
+					"%s, case #%d: nil pointer returned",
+					t.Name(), n+1,
+				)
+			}
+			if c.nullable.Int16 != *p {
+				t.Fatalf(
+					"%s, case #%d: literal mismatch (expected %d, got %d)",
+					t.Name(), n+1, c.nullable.Int16, *p,
+				)
+			}
+		} else {
+			if p != nil {
+				t.Fatalf(
+					"%s, case #%d: non-nil pointer returned",
+					t.Name(), n+1,
+				)
+			}
+		}
+	}
+}
+
+func TestInt16_Zero(t *testing.T) {
+	cases := []struct {
+		nullable null.Int16
+	}{
+		{null.Int16{Int16: 0, Valid: true}},
+		{null.Int16{Int16: 1, Valid: true}},
+		{null.Int16{Int16: -1, Valid: true}},
+		{null.Int16{}},
+		{null.Int16{Int16: 2, Valid: false}},
+		{null.Int16{Int16: -2, Valid: false}},
+	}
+
+	for n, c := range cases {
+		i := c.nullable.Zero()
+		if c.nullable.Valid {
+			if c.nullable.Int16 != i {
+				t.Fatalf(
+					"%s, case #%d: literal mismatch (expected %d, got %d)",
+					t.Name(), n+1, c.nullable.Int16, i,
+				)
+			}
+		} else {
+			if i != 0 {
+				t.Fatalf(
+					"%s, case #%d: non-zero uint returned",
+					t.Name(), n+1,
+				)
+			}
+		}
+	}
+}
+
+func TestInt16_From(t *testing.T) {
+	var i null.Int16
+	cases := []struct {
+		literal int16
+		valid   bool
+	}{
+		{0, true},
+		{1, true},
+		{-1, true},
+	}
+
+	for n, c := range cases {
+		i.From(c.literal)
+		if c.valid != i.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, i.Valid,
+			)
+		}
+		if !i.Valid {
+			continue
+		}
+
+		if c.literal != i.Int16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, i.Int16,
+			)
+		}
+	}
+}
+
+func TestInt16_FromPtr(t *testing.T) {
+	var i null.Int16
+	cases := []struct {
+		ptr   *int16
+		valid bool
+	}{
+		{int16p(0), true},
+		{int16p(1), true},
+		{int16p(-1), true},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		i.FromPtr(c.ptr)
+		if c.valid != i.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, i.Valid,
+			)
+		}
+		if !i.Valid {
+			continue
+		}
+
+		if *c.ptr != i.Int16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, *c.ptr, i.Int16,
+			)
+		}
+	}
+}
+
+func TestInt16_FromZero(t *testing.T) {
+	var i null.Int16
+	cases := []struct {
+		literal int16
+		valid   bool
+	}{
+		{0, false},
+		{1, true},
+		{-1, true},
+	}
+
+	for n, c := range cases {
+		i.FromZero(c.literal)
+		if c.valid != i.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, i.Valid,
+			)
+		}
+		if !i.Valid {
+			continue
+		}
+
+		if c.literal != i.Int16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, i.Int16,
+			)
+		}
+	}
+}
+
+func TestInt16_String(t *testing.T) {
+	cases := []struct {
+		nullable null.Int16
+		string   string
+	}{
+		{null.Int16{Int16: 0, Valid: true}, "0"},
+		{null.Int16{Int16: 1, Valid: true}, "1"},
+		{null.Int16{Int16: -1, Valid: true}, "-1"},
+		{null.Int16{}, "<invalid>"},
+		{null.Int16{Int16: 2, Valid: false}, "<invalid>"},
+		{null.Int16{Int16: -2, Valid: false}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestInt16_MarshalText(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+
+	cases := []struct {
+		nullable null.Int16
+		bytes    []byte
+		errType  reflect.Type
+	}{
+		{null.Int16{Int16: 0, Valid: true}, []byte("0"), nilType},
+		{null.Int16{Int16: 1, Valid: true}, []byte("1"), nilType},
+		{null.Int16{Int16: -1, Valid: true}, []byte("-1"), nilType},
+		{null.Int16{}, nil, nilType},
+		{null.Int16{Int16: 2, Valid: false}, nil, nilType},
+		{null.Int16{Int16: -2, Valid: false}, nil, nilType},
+	}
+
+	for n, c := range cases {
+		i, err := c.nullable.MarshalText()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if !reflect.DeepEqual(c.bytes, i) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(i),
+			)
+		}
+
+	}
+}
+
+func TestInt16_MarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+
+	cases := []struct {
+		nullable null.Int16
+		json     []byte
+		errType  reflect.Type
+	}{
+		{null.Int16{Int16: 0, Valid: true}, []byte("0"), nilType},
+		{null.Int16{Int16: 1, Valid: true}, []byte("1"), nilType},
+		{null.Int16{Int16: -1, Valid: true}, []byte("-1"), nilType},
+		{null.Int16{}, []byte("null"), nilType},
+		{null.Int16{Int16: 2, Valid: false}, []byte("null"), nilType},
+		{null.Int16{Int16: -2, Valid: false}, []byte("null"), nilType},
+	}
+
+	for n, c := range cases {
+		i, err := c.nullable.MarshalJSON()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if !reflect.DeepEqual(c.json, i) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(i),
+			)
+		}
+	}
+}
+
+func TestInt16_Value(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	i64Type := reflect.TypeOf(int64(0))
+
+	cases := []struct {
+		nullable null.Int16
+		expType  reflect.Type
+		errType  reflect.Type
+	}{
+		{null.Int16{Int16: 0, Valid: true}, i64Type, nilType},
+		{null.Int16{Int16: 1, Valid: true}, i64Type, nilType},
+		{null.Int16{Int16: -1, Valid: true}, i64Type, nilType},
+		{null.Int16{}, nilType, nilType},
+		{null.Int16{Int16: 2, Valid: false}, nilType, nilType},
+		{null.Int16{Int16: -2, Valid: false}, nilType, nilType},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.expType != reflect.TypeOf(v) {
+			t.Fatalf(
+				"%s, case #%d: type mismatch (expected %s, got %s)",
+				t.Name(), n+1, c.expType, reflect.TypeOf(v),
+			)
+		}
+		if v == nil {
+			continue
+		}
+
+		if int64(c.nullable.Int16) != reflect.ValueOf(v).Int() {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %v)",
+				t.Name(), n+1, c.nullable.Int16, v,
+			)
+		}
+	}
+}
+
+func TestInt16_Set(t *testing.T) {
+	var i null.Int16
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		literal int16
+		valid   bool
+		errType reflect.Type
+	}{
+		{"0", 0, true, nilType},
+		{"1", 1, true, nilType},
+		{"010", 8, true, nilType},
+		{"0x10", 16, true, nilType},
+		{"-1", -1, true, nilType},
+		{"-010", -8, true, nilType},
+		{"-0x10", -16, true, nilType},
+		{"", 0, false, nilType},
+		{"0.1", 0, false, parseErrType},
+		{"x", 0, false, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := i.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.valid != i.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, i.Valid,
+			)
+		}
+		if !i.Valid {
+			continue
+		}
+
+		if c.literal != i.Int16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, i.Int16,
+			)
+		}
+	}
+}
+
+func TestInt16_UnmarshalText(t *testing.T) {
+	var i null.Int16
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		literal int16
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("0"), 0, true, nilType},
+		{[]byte("1"), 1, true, nilType},
+		{[]byte("010"), 8, true, nilType},
+		{[]byte("0x10"), 16, true, nilType},
+		{[]byte("-1"), -1, true, nilType},
+		{[]byte("-010"), -8, true, nilType},
+		{[]byte("-0x10"), -16, true, nilType},
+		{nil, 0, false, nilType},
+		{[]byte(""), 0, false, nilType},
+		{[]byte("0.1"), 0, false, unmarshalErrType},
+		{[]byte("x"), 0, false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := i.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.valid != i.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, i.Valid,
+			)
+		}
+		if !i.Valid {
+			continue
+		}
+
+		if c.literal != i.Int16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, i.Int16,
+			)
+		}
+	}
+}
+
+func TestInt16_UnmarshalJSON(t *testing.T) {
+	var i null.Int16
+	nilType := reflect.TypeOf(nil)
+	cnvErrType := reflect.TypeOf(null.ConversionError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		literal int16
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("0"), 0, true, nilType},
+		{[]byte("1"), 1, true, nilType},
+		{[]byte("-1"), -1, true, nilType},
+		{[]byte("null"), 0, false, nilType},
+		{[]byte("0.1"), 0, false, cnvErrType},
+		{[]byte(`"x"`), 0, false, typeErrType},
+		{nil, 0, false, unmarshalErrType},
+		{[]byte("x"), 0, false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := i.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.valid != i.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, i.Valid,
+			)
+		}
+		if !i.Valid {
+			continue
+		}
+
+		if c.literal != i.Int16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, i.Int16,
+			)
+		}
+	}
+}
+
+func TestInt16_Scan(t *testing.T) {
+	var i null.Int16
+	nilType := reflect.TypeOf(nil)
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{int64(0), true, nilType},
+		{int64(1), true, nilType},
+		{int64(-1), true, nilType},
+		{nil, false, nilType},
+		{float64(0.1), false, typeErrType},
+		{"x", false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := i.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.valid != i.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, i.Valid,
+			)
+		}
+		if !i.Valid {
+			continue
+		}
+
+		if c.source.(int64) != int64(i.Int16) {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.source, i.Int16,
+			)
+		}
+	}
+}
+
+func TestInt16_Range(t *testing.T) {
+	var i null.Int16
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	cnvErrType := reflect.TypeOf(null.ConversionError{})
+
+	sets := []struct {
+		string  string
+		valid   bool
+		errType reflect.Type
+	}{
+		{"32767", true, nilType},
+		{"-32768", true, nilType},
+		{"32768", false, parseErrType},
+		{"-32769", false, parseErrType},
+	}
+
+	for n, c := range sets {
+		err := i.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, set #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != i.Valid {
+			t.Fatalf(
+				"%s, set #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, i.Valid,
+			)
+		}
+		if c.valid && c.string != i.String() {
+			t.Fatalf(
+				"%s, set #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, i.String(),
+			)
+		}
+	}
+
+	jsons := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("32767"), true, nilType},
+		{[]byte("-32768"), true, nilType},
+		{[]byte("32768"), false, cnvErrType},
+		{[]byte("-32769"), false, cnvErrType},
+	}
+
+	for n, c := range jsons {
+		err := i.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, json #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != i.Valid {
+			t.Fatalf(
+				"%s, json #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, i.Valid,
+			)
+		}
+		if c.valid && string(c.json) != i.String() {
+			t.Fatalf(
+				"%s, json #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), i.String(),
+			)
+		}
+	}
+
+	scans := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{int64(32768), false, cnvErrType},
+		{int64(-32769), false, cnvErrType},
+	}
+
+	for n, c := range scans {
+		err := i.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, scan #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != i.Valid {
+			t.Fatalf(
+				"%s, scan #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, i.Valid,
+			)
+		}
+	}
+}
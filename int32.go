@@ -0,0 +1,242 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strconv"
+)
+
+// Int32 implements a nullable int32.
+type Int32 struct {
+	// Int32 holds the underlying int32 value.
+	Int32 int32
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// Int32From creates a valid Int32 from v.
+func Int32From(v int32) Int32 {
+	return Int32FromPtr(&v)
+}
+
+// Int32FromPtr creates an Int32 from pointer p. If p is nil,
+// the returned Int32 is invalid.
+func Int32FromPtr(p *int32) Int32 {
+	if p != nil {
+		return Int32{
+			Int32: *p,
+			Valid: true,
+		}
+	}
+	return Int32{}
+}
+
+// Int32FromZero creates an Int32 from v. If v is 0,
+// the returned Int32 is invalid.
+func Int32FromZero(v int32) Int32 {
+	return Int32{
+		Int32: v,
+		Valid: v != 0,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of i if i is valid,
+// otherwise returns nil.
+func (i Int32) Ptr() *int32 {
+	if i.Valid {
+		return &i.Int32
+	}
+	return nil
+}
+
+// Zero returns the underlying value of i if i is valid, otherwise
+// returns 0.
+func (i Int32) Zero() int32 {
+	if i.Valid {
+		return i.Int32
+	}
+	return 0
+}
+
+// From sets the underlying value of i to v. i becomes valid.
+func (i *Int32) From(v int32) {
+	i.Valid = true
+	i.Int32 = v
+}
+
+// FromPtr invalidates i if p is nil, otherwise it sets the underlying value
+// of i to the value pointed to by p, and i becomes valid.
+func (i *Int32) FromPtr(p *int32) {
+	i.Valid = p != nil
+	if p != nil {
+		i.Int32 = *p
+	}
+}
+
+// FromZero invalidates i if v is 0,
+// otherwise it sets the underlying value of i to v, and i becomes valid.
+func (i *Int32) FromZero(v int32) {
+	i.Valid = v != 0
+	i.Int32 = v
+}
+
+// IsValid returns true if i is valid.
+func (i Int32) IsValid() bool {
+	return i.Valid
+}
+
+// Invalidate makes i invalid.
+func (i *Int32) Invalidate() {
+	i.Valid = false
+}
+
+// Interface returns the underlying value of i as int32 if i is valid,
+// otherwise nil.
+func (i Int32) Interface() interface{} {
+	if i.Valid {
+		return i.Int32
+	}
+	return nil
+}
+
+// SetInterface invalidates i if v is nil, otherwise if v's type is int32,
+// it sets the underlying value of i to v, and i becomes valid.
+// If v's type is any other type, i becomes invalid, and a TypeError is
+// returned.
+func (i *Int32) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case int32:
+		i.Int32 = value
+		i.Valid = true
+		return nil
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		i.Valid = false
+		return makeTypeError("set", value, "int32", "nil")
+	}
+}
+
+// String returns a string representation of i.
+// If i is valid, it returns a string representation of the underlying value
+// of i, otherwise it returns InvalidNullableString.
+func (i Int32) String() string {
+	if i.Valid {
+		return strconv.FormatInt(int64(i.Int32), 10)
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals i to a byte string representation.
+// If i is valid, it marshals the underlying value of i to a byte string
+// representation, otherwise it returns nil. err is always nil.
+func (i Int32) MarshalText() (data []byte, err error) {
+	if i.Valid {
+		return []byte(i.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of i to a JSON number if i is
+// valid, otherwise it returns the JSON null value. err is always nil.
+func (i Int32) MarshalJSON() (data []byte, err error) {
+	if i.Valid {
+		return []byte(i.String()), nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of i converted to int64 if i is valid,
+// otherwise nil. err is always nil.
+func (i Int32) Value() (v driver.Value, err error) {
+	if i.Valid {
+		return int64(i.Int32), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates i if str is the empty string, otherwise it parses str into
+// the underlying value of i, and i becomes valid. If str is not a valid
+// string representation of an integer, or if the represented integer is too
+// large to be stored in an int32, i becomes invalid and a ParseError is
+// returned.
+func (i *Int32) Set(str string) error {
+	val, err := strconv.ParseInt(str, 0, 32)
+	i.Int32 = int32(val)
+	i.Valid = err == nil && str != ""
+	if str == "" || err == nil {
+		return nil
+	}
+
+	return makeParseError("parse", str, i.Int32)
+}
+
+// UnmarshalText unmarshals from a byte string to i.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (i *Int32) UnmarshalText(text []byte) error {
+	if i.Set(string(text)) != nil {
+		return makeUnmarshalError("parse", text, *i)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to i.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, i becomes invalid. If the encoded JSON data
+// represent a JSON number, and can be stored in an int32 without data
+// loss, i becomes valid, and the underlying value of i is set to the JSON
+// number. If the encoded JSON data represent a JSON number,
+// and cannot be stored in an int32 without data loss, i becomes invalid,
+// and a ConversionError is returned. Other JSON types
+// produce a TypeError. Malformed JSON produces an UnmarshalError.
+func (i *Int32) UnmarshalJSON(data []byte) error {
+	var obj interface{}
+	if json.Unmarshal(data, &obj) != nil {
+		i.Valid = false
+		return makeUnmarshalError("json", data, *i)
+	}
+	switch value := obj.(type) {
+	case float64:
+		i.Int32 = int32(value)
+		i.Valid = value == float64(i.Int32)
+		if !i.Valid {
+			return makeConversionError("json", value, i.Int32)
+		}
+		return nil
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		i.Valid = false
+		return makeTypeError("json", value, "float64", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is int64,
+// if the number can be stored in an int32 without data loss,
+// i becomes valid, and the underlying value of i becomes the value of obj.
+// If obj's type is int64, and the number cannot be stored in an int32 without
+// data loss, i becomes invalid, and a ConversionError is returned.
+// If obj is nil, i becomes invalid. If obj's type is any other type,
+// i becomes invalid, and a TypeError is returned.
+func (i *Int32) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case int64:
+		i.Int32 = int32(value)
+		i.Valid = value == int64(i.Int32)
+		if !i.Valid {
+			return makeConversionError("sql", value, i.Int32)
+		}
+		return nil
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		i.Valid = false
+		return makeTypeError("sql", value, "int64", "nil")
+	}
+}
@@ -0,0 +1,248 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+)
+
+// Int64 implements a nullable int64.
+type Int64 struct {
+	// Int64 holds the underlying int64 value.
+	Int64 int64
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// Int64From creates a valid Int64 from v.
+func Int64From(v int64) Int64 {
+	return Int64FromPtr(&v)
+}
+
+// Int64FromPtr creates an Int64 from pointer p. If p is nil,
+// the returned Int64 is invalid.
+func Int64FromPtr(p *int64) Int64 {
+	if p != nil {
+		return Int64{
+			Int64: *p,
+			Valid: true,
+		}
+	}
+	return Int64{}
+}
+
+// Int64FromZero creates an Int64 from v. If v is 0,
+// the returned Int64 is invalid.
+func Int64FromZero(v int64) Int64 {
+	return Int64{
+		Int64: v,
+		Valid: v != 0,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of i if i is valid,
+// otherwise returns nil.
+func (i Int64) Ptr() *int64 {
+	if i.Valid {
+		return &i.Int64
+	}
+	return nil
+}
+
+// Zero returns the underlying value of i if i is valid, otherwise
+// returns 0.
+func (i Int64) Zero() int64 {
+	if i.Valid {
+		return i.Int64
+	}
+	return 0
+}
+
+// From sets the underlying value of i to v. i becomes valid.
+func (i *Int64) From(v int64) {
+	i.Valid = true
+	i.Int64 = v
+}
+
+// FromPtr invalidates i if p is nil, otherwise it sets the underlying value
+// of i to the value pointed to by p, and i becomes valid.
+func (i *Int64) FromPtr(p *int64) {
+	i.Valid = p != nil
+	if p != nil {
+		i.Int64 = *p
+	}
+}
+
+// FromZero invalidates i if v is 0,
+// otherwise it sets the underlying value of i to v, and i becomes valid.
+func (i *Int64) FromZero(v int64) {
+	i.Valid = v != 0
+	i.Int64 = v
+}
+
+// IsValid returns true if i is valid.
+func (i Int64) IsValid() bool {
+	return i.Valid
+}
+
+// Invalidate makes i invalid.
+func (i *Int64) Invalidate() {
+	i.Valid = false
+}
+
+// Interface returns the underlying value of i as int64 if i is valid,
+// otherwise nil.
+func (i Int64) Interface() interface{} {
+	if i.Valid {
+		return i.Int64
+	}
+	return nil
+}
+
+// SetInterface invalidates i if v is nil, otherwise if v's type is int64,
+// it sets the underlying value of i to v, and i becomes valid.
+// If v's type is any other type, i becomes invalid, and a TypeError is
+// returned.
+func (i *Int64) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case int64:
+		i.Int64 = value
+		i.Valid = true
+		return nil
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		i.Valid = false
+		return makeTypeError("set", value, "int64", "nil")
+	}
+}
+
+// String returns a string representation of i.
+// If i is valid, it returns a string representation of the underlying value
+// of i, otherwise it returns InvalidNullableString.
+func (i Int64) String() string {
+	if i.Valid {
+		return strconv.FormatInt(i.Int64, 10)
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals i to a byte string representation.
+// If i is valid, it marshals the underlying value of i to a byte string
+// representation, otherwise it returns nil. err is always nil.
+func (i Int64) MarshalText() (data []byte, err error) {
+	if i.Valid {
+		return []byte(i.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of i to a JSON number if i is
+// valid, otherwise it returns the JSON null value. err is always nil.
+func (i Int64) MarshalJSON() (data []byte, err error) {
+	if i.Valid {
+		return []byte(i.String()), nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of i if i is valid,
+// otherwise nil. err is always nil.
+func (i Int64) Value() (v driver.Value, err error) {
+	if i.Valid {
+		return i.Int64, nil
+	}
+	return nil, nil
+}
+
+// Set invalidates i if str is the empty string, otherwise it parses str into
+// the underlying value of i, and i becomes valid. If str is not a valid
+// string representation of an integer, or if the represented integer is too
+// large to be stored in an int64, i becomes invalid and a ParseError is
+// returned.
+func (i *Int64) Set(str string) error {
+	var err error
+	i.Int64, err = strconv.ParseInt(str, 0, 64)
+	i.Valid = err == nil && str != ""
+	if str == "" || err == nil {
+		return nil
+	}
+
+	return makeParseError("parse", str, i.Int64)
+}
+
+// UnmarshalText unmarshals from a byte string to i.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (i *Int64) UnmarshalText(text []byte) error {
+	if i.Set(string(text)) != nil {
+		return makeUnmarshalError("parse", text, *i)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to i.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, i becomes invalid. If the encoded JSON data
+// represent a JSON number, and can be stored in an int64 without data
+// loss, i becomes valid, and the underlying value of i is set to the JSON
+// number. Unlike Int, the JSON number is not converted to float64 first,
+// so that integers beyond 2^53 are not rounded. If the encoded JSON data
+// represent a JSON number, and cannot be stored in an int64 without data
+// loss, i becomes invalid, and a ConversionError is returned. Other JSON
+// types produce a TypeError. Malformed JSON produces an UnmarshalError.
+func (i *Int64) UnmarshalJSON(data []byte) error {
+	obj, ok := decodeJSONNumber(data)
+	if !ok {
+		i.Valid = false
+		return makeUnmarshalError("json", data, *i)
+	}
+	switch value := obj.(type) {
+	case json.Number:
+		var err error
+		i.Int64, err = value.Int64()
+		if errors.Is(err, strconv.ErrRange) {
+			err = makeConversionError("json", value, i.Int64)
+		} else if err != nil {
+			// accept integral numbers in other notations, such as 1e3
+			f, _ := value.Float64()
+			i.Int64 = int64(f)
+			err = nil
+			if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+				err = makeConversionError("json", value, i.Int64)
+			}
+		}
+		i.Valid = err == nil
+		return err
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		i.Valid = false
+		return makeTypeError("json", value, "json.Number", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is int64,
+// i becomes valid, and the underlying value of i becomes the value of obj.
+// If obj is nil, i becomes invalid. If obj's type is any other type,
+// i becomes invalid, and a TypeError is returned.
+func (i *Int64) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case int64:
+		i.Int64 = value
+		i.Valid = true
+		return nil
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		i.Valid = false
+		return makeTypeError("sql", value, "int64", "nil")
+	}
+}
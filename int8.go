@@ -0,0 +1,242 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strconv"
+)
+
+// Int8 implements a nullable int8.
+type Int8 struct {
+	// Int8 holds the underlying int8 value.
+	Int8 int8
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// Int8From creates a valid Int8 from v.
+func Int8From(v int8) Int8 {
+	return Int8FromPtr(&v)
+}
+
+// Int8FromPtr creates an Int8 from pointer p. If p is nil,
+// the returned Int8 is invalid.
+func Int8FromPtr(p *int8) Int8 {
+	if p != nil {
+		return Int8{
+			Int8:  *p,
+			Valid: true,
+		}
+	}
+	return Int8{}
+}
+
+// Int8FromZero creates an Int8 from v. If v is 0,
+// the returned Int8 is invalid.
+func Int8FromZero(v int8) Int8 {
+	return Int8{
+		Int8:  v,
+		Valid: v != 0,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of i if i is valid,
+// otherwise returns nil.
+func (i Int8) Ptr() *int8 {
+	if i.Valid {
+		return &i.Int8
+	}
+	return nil
+}
+
+// Zero returns the underlying value of i if i is valid, otherwise
+// returns 0.
+func (i Int8) Zero() int8 {
+	if i.Valid {
+		return i.Int8
+	}
+	return 0
+}
+
+// From sets the underlying value of i to v. i becomes valid.
+func (i *Int8) From(v int8) {
+	i.Valid = true
+	i.Int8 = v
+}
+
+// FromPtr invalidates i if p is nil, otherwise it sets the underlying value
+// of i to the value pointed to by p, and i becomes valid.
+func (i *Int8) FromPtr(p *int8) {
+	i.Valid = p != nil
+	if p != nil {
+		i.Int8 = *p
+	}
+}
+
+// FromZero invalidates i if v is 0,
+// otherwise it sets the underlying value of i to v, and i becomes valid.
+func (i *Int8) FromZero(v int8) {
+	i.Valid = v != 0
+	i.Int8 = v
+}
+
+// IsValid returns true if i is valid.
+func (i Int8) IsValid() bool {
+	return i.Valid
+}
+
+// Invalidate makes i invalid.
+func (i *Int8) Invalidate() {
+	i.Valid = false
+}
+
+// Interface returns the underlying value of i as int8 if i is valid,
+// otherwise nil.
+func (i Int8) Interface() interface{} {
+	if i.Valid {
+		return i.Int8
+	}
+	return nil
+}
+
+// SetInterface invalidates i if v is nil, otherwise if v's type is int8,
+// it sets the underlying value of i to v, and i becomes valid.
+// If v's type is any other type, i becomes invalid, and a TypeError is
+// returned.
+func (i *Int8) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case int8:
+		i.Int8 = value
+		i.Valid = true
+		return nil
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		i.Valid = false
+		return makeTypeError("set", value, "int8", "nil")
+	}
+}
+
+// String returns a string representation of i.
+// If i is valid, it returns a string representation of the underlying value
+// of i, otherwise it returns InvalidNullableString.
+func (i Int8) String() string {
+	if i.Valid {
+		return strconv.FormatInt(int64(i.Int8), 10)
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals i to a byte string representation.
+// If i is valid, it marshals the underlying value of i to a byte string
+// representation, otherwise it returns nil. err is always nil.
+func (i Int8) MarshalText() (data []byte, err error) {
+	if i.Valid {
+		return []byte(i.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of i to a JSON number if i is
+// valid, otherwise it returns the JSON null value. err is always nil.
+func (i Int8) MarshalJSON() (data []byte, err error) {
+	if i.Valid {
+		return []byte(i.String()), nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of i converted to int64 if i is valid,
+// otherwise nil. err is always nil.
+func (i Int8) Value() (v driver.Value, err error) {
+	if i.Valid {
+		return int64(i.Int8), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates i if str is the empty string, otherwise it parses str into
+// the underlying value of i, and i becomes valid. If str is not a valid
+// string representation of an integer, or if the represented integer is too
+// large to be stored in an int8, i becomes invalid and a ParseError is
+// returned.
+func (i *Int8) Set(str string) error {
+	val, err := strconv.ParseInt(str, 0, 8)
+	i.Int8 = int8(val)
+	i.Valid = err == nil && str != ""
+	if str == "" || err == nil {
+		return nil
+	}
+
+	return makeParseError("parse", str, i.Int8)
+}
+
+// UnmarshalText unmarshals from a byte string to i.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (i *Int8) UnmarshalText(text []byte) error {
+	if i.Set(string(text)) != nil {
+		return makeUnmarshalError("parse", text, *i)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to i.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, i becomes invalid. If the encoded JSON data
+// represent a JSON number, and can be stored in an int8 without data
+// loss, i becomes valid, and the underlying value of i is set to the JSON
+// number. If the encoded JSON data represent a JSON number,
+// and cannot be stored in an int8 without data loss, i becomes invalid,
+// and a ConversionError is returned. Other JSON types
+// produce a TypeError. Malformed JSON produces an UnmarshalError.
+func (i *Int8) UnmarshalJSON(data []byte) error {
+	var obj interface{}
+	if json.Unmarshal(data, &obj) != nil {
+		i.Valid = false
+		return makeUnmarshalError("json", data, *i)
+	}
+	switch value := obj.(type) {
+	case float64:
+		i.Int8 = int8(value)
+		i.Valid = value == float64(i.Int8)
+		if !i.Valid {
+			return makeConversionError("json", value, i.Int8)
+		}
+		return nil
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		i.Valid = false
+		return makeTypeError("json", value, "float64", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is int64,
+// if the number can be stored in an int8 without data loss,
+// i becomes valid, and the underlying value of i becomes the value of obj.
+// If obj's type is int64, and the number cannot be stored in an int8 without
+// data loss, i becomes invalid, and a ConversionError is returned.
+// If obj is nil, i becomes invalid. If obj's type is any other type,
+// i becomes invalid, and a TypeError is returned.
+func (i *Int8) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case int64:
+		i.Int8 = int8(value)
+		i.Valid = value == int64(i.Int8)
+		if !i.Valid {
+			return makeConversionError("sql", value, i.Int8)
+		}
+		return nil
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		i.Valid = false
+		return makeTypeError("sql", value, "int64", "nil")
+	}
+}
@@ -0,0 +1,253 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strconv"
+)
+
+// IntArray implements a nullable []Int64, meant to be used with PostgreSQL
+// bigint[] columns. Elements are Int64 values, so that NULL elements are
+// represented by invalid Int64 values. IntArray is represented in text and SQL
+// by a PostgreSQL array literal, such as {1,NULL,3}, and in JSON by an array
+// holding null for NULL elements.
+type IntArray struct {
+	// Array holds the underlying []Int64 value.
+	Array []Int64
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// IntArrayFrom creates a valid IntArray from v.
+func IntArrayFrom(v []Int64) IntArray {
+	return IntArrayFromPtr(&v)
+}
+
+// IntArrayFromPtr creates an IntArray from pointer p. If p is nil,
+// the returned IntArray is invalid.
+func IntArrayFromPtr(p *[]Int64) IntArray {
+	if p != nil {
+		return IntArray{
+			Array: *p,
+			Valid: true,
+		}
+	}
+	return IntArray{}
+}
+
+// IntArrayFromZero creates an IntArray from v. If v is nil, the returned
+// IntArray is invalid. An empty but non-nil v produces a valid IntArray.
+func IntArrayFromZero(v []Int64) IntArray {
+	return IntArray{
+		Array: v,
+		Valid: v != nil,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of a if a is valid,
+// otherwise returns nil.
+func (a IntArray) Ptr() *[]Int64 {
+	if a.Valid {
+		return &a.Array
+	}
+	return nil
+}
+
+// Zero returns the underlying value of a if a is valid, otherwise returns
+// nil.
+func (a IntArray) Zero() []Int64 {
+	if a.Valid {
+		return a.Array
+	}
+	return nil
+}
+
+// From sets the underlying value of a to v. a becomes valid.
+func (a *IntArray) From(v []Int64) {
+	a.Array = v
+	a.Valid = true
+}
+
+// FromPtr invalidates a if p is nil, otherwise it sets the underlying value
+// of a to the value pointed to by p, and a becomes valid.
+func (a *IntArray) FromPtr(p *[]Int64) {
+	a.Valid = p != nil
+	if p != nil {
+		a.Array = *p
+	}
+}
+
+// FromZero invalidates a if v is nil, otherwise it sets the underlying value
+// of a to v, and a becomes valid.
+func (a *IntArray) FromZero(v []Int64) {
+	a.Array = v
+	a.Valid = v != nil
+}
+
+// IsValid returns true if a is valid.
+func (a IntArray) IsValid() bool {
+	return a.Valid
+}
+
+// Invalidate makes a invalid.
+func (a *IntArray) Invalidate() {
+	a.Valid = false
+}
+
+// Interface returns the underlying value of a as []Int64 if a is valid,
+// otherwise nil.
+func (a IntArray) Interface() interface{} {
+	if a.Valid {
+		return a.Array
+	}
+	return nil
+}
+
+// SetInterface invalidates a if v is nil, otherwise if v's type is
+// []Int64, it sets the underlying value of a to v, and a becomes valid.
+// If v's type is any other type, a becomes invalid, and a TypeError is
+// returned.
+func (a *IntArray) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case []Int64:
+		a.From(value)
+		return nil
+	case nil:
+		a.Valid = false
+		return nil
+	default:
+		a.Valid = false
+		return makeTypeError("set", value, "[]Int64", "nil")
+	}
+}
+
+// String returns a string representation of a. If a is valid,
+// it returns the PostgreSQL array literal holding the elements of a,
+// otherwise it returns InvalidNullableString.
+func (a IntArray) String() string {
+	if a.Valid {
+		return a.literal()
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals a to a byte string representation. If a is valid,
+// it returns the PostgreSQL array literal holding the elements of a,
+// otherwise it returns nil. err is always nil.
+func (a IntArray) MarshalText() (data []byte, err error) {
+	if a.Valid {
+		return []byte(a.literal()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of a to a JSON array if a is
+// valid, even if the underlying value of a is nil, otherwise it returns the
+// JSON null value. Invalid elements are encoded as the JSON null value.
+// err is always nil.
+func (a IntArray) MarshalJSON() (data []byte, err error) {
+	if !a.Valid {
+		return jNull, nil
+	}
+	v := a.Array
+	if v == nil {
+		v = []Int64{}
+	}
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return nil, makeMarshalError("json", a)
+	}
+	return bytes, nil
+}
+
+// Value returns the PostgreSQL array literal holding the elements of a if
+// a is valid, otherwise nil. err is always nil.
+func (a IntArray) Value() (v driver.Value, err error) {
+	if a.Valid {
+		return a.literal(), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates a if str is the empty string, otherwise it parses str,
+// which holds a one-dimensional PostgreSQL array literal, into the
+// underlying value of a, and a becomes valid. Unquoted NULL elements become
+// invalid elements. If str holds a multi-dimensional array, a becomes
+// invalid and a DimensionError is returned. If str cannot be parsed,
+// a becomes invalid and a ParseError is returned.
+func (a *IntArray) Set(str string) error {
+	if str == "" {
+		a.Valid = false
+		return nil
+	}
+	return a.parse("parse", str)
+}
+
+// UnmarshalText unmarshals from a byte string to a.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError or a DimensionError in case text cannot be parsed.
+func (a *IntArray) UnmarshalText(text []byte) error {
+	if a.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *a)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to a.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, a becomes invalid. If the encoded JSON data
+// represent a JSON array, a becomes valid, and the underlying value of a is
+// set to a new slice holding the elements of the array, which are
+// unmarshaled like Int64.UnmarshalJSON does. Malformed JSON, other JSON types,
+// or elements which Int64.UnmarshalJSON rejects produce an UnmarshalError.
+func (a *IntArray) UnmarshalJSON(data []byte) error {
+	var v []Int64
+	if json.Unmarshal(data, &v) != nil {
+		a.Valid = false
+		return makeUnmarshalError("json", data, *a)
+	}
+	a.Array, a.Valid = v, v != nil
+	return nil
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is parsed like Set does, except that the empty string produces
+// a ParseError. If obj is nil, a becomes invalid. If obj's type is any other
+// type, a becomes invalid, and a TypeError is returned.
+func (a *IntArray) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case string:
+		return a.parse("sql", value)
+	case []byte:
+		return a.parse("sql", string(value))
+	case nil:
+		a.Valid = false
+		return nil
+	default:
+		a.Valid = false
+		return makeTypeError("sql", value, "string", "[]byte", "nil")
+	}
+}
+
+// literal returns the PostgreSQL array literal holding the elements of a.
+func (a IntArray) literal() string {
+	return formatArrayOf(a.Array, func(e Int64) (string, bool) {
+		return strconv.FormatInt(e.Int64, 10), e.Valid
+	})
+}
+
+// parse parses str as a one-dimensional PostgreSQL array literal into the
+// underlying value of a. The returned errors use the given prefix.
+func (a *IntArray) parse(prefix, str string) error {
+	v, err := parseArrayOf(prefix, str, *a, func(e arrayElem) (Int64, bool) {
+		if e.null {
+			return Int64{}, true
+		}
+		v, err := strconv.ParseInt(e.str, 10, 64)
+		return Int64From(v), err == nil
+	})
+	a.Array, a.Valid = v, err == nil
+	return err
+}
@@ -0,0 +1,226 @@
+package null_test
+
+import (
+	"null"
+	"reflect"
+	"testing"
+)
+
+func TestIntArray_String(t *testing.T) {
+	cases := []struct {
+		nullable null.IntArray
+		string   string
+	}{
+		{
+			null.IntArrayFrom([]null.Int64{null.Int64From(-1), {}, null.Int64From(3)}),
+			"{-1,NULL,3}",
+		},
+		{null.IntArrayFrom([]null.Int64{}), "{}"},
+		{null.IntArray{}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestIntArray_MarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+
+	cases := []struct {
+		nullable null.IntArray
+		json     []byte
+		errType  reflect.Type
+	}{
+		{
+			null.IntArrayFrom([]null.Int64{null.Int64From(1), {}}),
+			[]byte("[1,null]"),
+			nilType,
+		},
+		{null.IntArrayFrom(nil), []byte("[]"), nilType},
+		{null.IntArray{}, []byte("null"), nilType},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestIntArray_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.IntArray
+		value    interface{}
+	}{
+		{null.IntArrayFrom([]null.Int64{null.Int64From(1), {}}), "{1,NULL}"},
+		{null.IntArray{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestIntArray_Set(t *testing.T) {
+	var a null.IntArray
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	dimErrType := reflect.TypeOf(null.DimensionError{})
+
+	cases := []struct {
+		string  string
+		valid   bool
+		value   []null.Int64
+		errType reflect.Type
+	}{
+		{
+			"{1,NULL, -3}",
+			true,
+			[]null.Int64{null.Int64From(1), {}, null.Int64From(-3)},
+			nilType,
+		},
+		{
+			"{9223372036854775807}",
+			true,
+			[]null.Int64{null.Int64From(9223372036854775807)},
+			nilType,
+		},
+		{"{9223372036854775808}", false, nil, parseErrType},
+		{"{0x10}", false, nil, parseErrType},
+		{"{1.5}", false, nil, parseErrType},
+		{"{}", true, []null.Int64{}, nilType},
+		{"", false, nil, nilType},
+		{"{NULL,}", false, nil, parseErrType},
+		{"{{NULL}}", false, nil, dimErrType},
+	}
+
+	for n, c := range cases {
+		err := a.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != a.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, a.Valid,
+			)
+		}
+		if a.Valid && !reflect.DeepEqual(c.value, a.Array) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, a.Array,
+			)
+		}
+	}
+}
+
+func TestIntArray_UnmarshalJSON(t *testing.T) {
+	var a null.IntArray
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		value   []null.Int64
+		errType reflect.Type
+	}{
+		{
+			[]byte("[1,null]"),
+			true,
+			[]null.Int64{null.Int64From(1), {}},
+			nilType,
+		},
+		{[]byte("[1.5]"), false, nil, unmarshalErrType},
+		{[]byte("[]"), true, []null.Int64{}, nilType},
+		{[]byte("null"), false, nil, nilType},
+		{[]byte(`[{}]`), false, nil, unmarshalErrType},
+		{[]byte("x"), false, nil, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := a.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != a.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, a.Valid,
+			)
+		}
+		if a.Valid && !reflect.DeepEqual(c.value, a.Array) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, a.Array,
+			)
+		}
+	}
+}
+
+func TestIntArray_Scan(t *testing.T) {
+	var a null.IntArray
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{"{1,NULL}", true, nilType},
+		{[]byte("{1,2}"), true, nilType},
+		{"{a}", false, parseErrType},
+		{nil, false, nilType},
+		{"", false, parseErrType},
+		{int64(1), false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := a.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != a.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, a.Valid,
+			)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package null
+
+import (
+	"database/sql/driver"
+	"slices"
+	"strconv"
+)
+
+// IntEnumerable is satisfied by the signed integer types which declare their
+// allowed values with a Values method, for instance:
+//
+//	type Priority int
+//
+//	func (Priority) Values() []Priority {
+//	    return []Priority{1, 2, 3}
+//	}
+type IntEnumerable[T any] interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+	Values() []T
+}
+
+// IntEnum implements a nullable T, where T is an integer type whose values
+// are restricted to the set returned by its Values method, such as the codes
+// of a status column. It behaves like Enum, except that values are
+// represented as integers in text, JSON and SQL.
+type IntEnum[T IntEnumerable[T]] struct {
+	// Val holds the underlying T value.
+	Val T
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// IntEnumFrom creates a valid IntEnum from v.
+func IntEnumFrom[T IntEnumerable[T]](v T) IntEnum[T] {
+	return IntEnumFromPtr(&v)
+}
+
+// IntEnumFromPtr creates an IntEnum from pointer p. If p is nil,
+// the returned IntEnum is invalid.
+func IntEnumFromPtr[T IntEnumerable[T]](p *T) IntEnum[T] {
+	if p != nil {
+		return IntEnum[T]{
+			Val:   *p,
+			Valid: true,
+		}
+	}
+	return IntEnum[T]{}
+}
+
+// IntEnumFromZero creates an IntEnum from v. If v is 0,
+// the returned IntEnum is invalid.
+func IntEnumFromZero[T IntEnumerable[T]](v T) IntEnum[T] {
+	return IntEnum[T]{
+		Val:   v,
+		Valid: v != 0,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of e if e is valid,
+// otherwise returns nil.
+func (e IntEnum[T]) Ptr() *T {
+	if e.Valid {
+		return &e.Val
+	}
+	return nil
+}
+
+// Zero returns the underlying value of e if e is valid, otherwise
+// returns 0.
+func (e IntEnum[T]) Zero() T {
+	if e.Valid {
+		return e.Val
+	}
+	return 0
+}
+
+// From sets the underlying value of e to v. e becomes valid. v is not
+// checked against the allowed set of T until e is stored by Value.
+func (e *IntEnum[T]) From(v T) {
+	e.Valid = true
+	e.Val = v
+}
+
+// FromPtr invalidates e if p is nil, otherwise it sets the underlying value
+// of e to the value pointed to by p, and e becomes valid.
+func (e *IntEnum[T]) FromPtr(p *T) {
+	e.Valid = p != nil
+	if p != nil {
+		e.Val = *p
+	}
+}
+
+// FromZero invalidates e if v is 0, otherwise it sets the underlying value
+// of e to v, and e becomes valid.
+func (e *IntEnum[T]) FromZero(v T) {
+	e.Valid = v != 0
+	e.Val = v
+}
+
+// IsValid returns true if e is valid.
+func (e IntEnum[T]) IsValid() bool {
+	return e.Valid
+}
+
+// Invalidate makes e invalid.
+func (e *IntEnum[T]) Invalidate() {
+	e.Valid = false
+}
+
+// Interface returns the underlying value of e as T if e is valid,
+// otherwise nil.
+func (e IntEnum[T]) Interface() interface{} {
+	if e.Valid {
+		return e.Val
+	}
+	return nil
+}
+
+// SetInterface invalidates e if v is nil, otherwise if v's type is T,
+// it sets the underlying value of e to v, and e becomes valid.
+// If v's type is any other type, e becomes invalid, and a TypeError is
+// returned.
+func (e *IntEnum[T]) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case T:
+		e.From(value)
+		return nil
+	case nil:
+		e.Valid = false
+		return nil
+	default:
+		e.Valid = false
+		return makeTypeError("set", value, typeName(e.Val), "nil")
+	}
+}
+
+// Allowed returns a copy of the allowed set of T, in the order returned by
+// its Values method.
+func (e IntEnum[T]) Allowed() []T {
+	return slices.Clone(e.Val.Values())
+}
+
+// String returns a string representation of e.
+// If e is valid, it returns a string representation of the underlying value
+// of e, otherwise it returns InvalidNullableString.
+func (e IntEnum[T]) String() string {
+	if e.Valid {
+		return strconv.FormatInt(int64(e.Val), 10)
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals e to a byte string representation.
+// If e is valid, it marshals the underlying value of e to a byte string
+// representation, otherwise it returns nil. err is always nil.
+func (e IntEnum[T]) MarshalText() (data []byte, err error) {
+	if e.Valid {
+		return []byte(e.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of e to a JSON number if e is
+// valid, otherwise it returns the JSON null value. err is always nil.
+func (e IntEnum[T]) MarshalJSON() (data []byte, err error) {
+	if e.Valid {
+		return []byte(e.String()), nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of e as an int64 if e is valid,
+// otherwise nil. If the underlying value of e is not in the allowed set of
+// T, an EnumError is returned.
+func (e IntEnum[T]) Value() (v driver.Value, err error) {
+	if !e.Valid {
+		return nil, nil
+	}
+	if !e.allowed(e.Val) {
+		return nil, e.enumError("sql", e.Val)
+	}
+	return int64(e.Val), nil
+}
+
+// Set invalidates e if str is the empty string, otherwise it parses str into
+// the underlying value of e like Int64.Set does, and e becomes valid. If str
+// cannot be parsed, or the parsed integer cannot be stored in a T, e becomes
+// invalid and a ParseError is returned. If the parsed integer is not in the
+// allowed set of T, e becomes invalid and an EnumError is returned.
+func (e *IntEnum[T]) Set(str string) error {
+	return e.parse("parse", str)
+}
+
+// UnmarshalText unmarshals from a byte string to e. It behaves like Set,
+// except that it returns an UnmarshalError instead of a ParseError in case
+// text cannot be parsed, and that the prefix of the returned EnumError is
+// "text".
+func (e *IntEnum[T]) UnmarshalText(text []byte) error {
+	err := e.parse("text", string(text))
+	if _, ok := err.(ParseError); ok {
+		return makeUnmarshalError("text", text, *e)
+	}
+	return err
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to e.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, e becomes invalid. If the encoded JSON data
+// represent a JSON number, it is unmarshaled like Int64.UnmarshalJSON does,
+// and e becomes valid, unless the number cannot be stored in a T, in which
+// case a ConversionError is returned, or it is not in the allowed set of T,
+// in which case an EnumError is returned. Other JSON types produce a
+// TypeError. Malformed JSON produces an UnmarshalError.
+func (e *IntEnum[T]) UnmarshalJSON(data []byte) error {
+	var i Int64
+	err := i.UnmarshalJSON(data)
+	if _, ok := err.(UnmarshalError); ok {
+		e.Valid = false
+		return makeUnmarshalError("json", data, *e)
+	}
+	if err != nil || !i.Valid {
+		e.Valid = false
+		return err
+	}
+	return e.assign("json", i.Int64)
+}
+
+// Scan assigns a value from a database driver. If obj's type is int64,
+// e becomes valid, and the underlying value of e becomes the value of obj,
+// unless it cannot be stored in a T, in which case a ConversionError is
+// returned, or it is not in the allowed set of T, in which case an EnumError
+// is returned. If obj is nil, e becomes invalid. If obj's type is any other
+// type, e becomes invalid, and a TypeError is returned.
+func (e *IntEnum[T]) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case int64:
+		return e.assign("sql", value)
+	case nil:
+		e.Valid = false
+		return nil
+	default:
+		e.Valid = false
+		return makeTypeError("sql", value, "int64", "nil")
+	}
+}
+
+// parse parses str like Set does. Errors are returned with the given
+// prefix.
+func (e *IntEnum[T]) parse(prefix, str string) error {
+	var i Int64
+	if i.Set(str) != nil || (i.Valid && int64(T(i.Int64)) != i.Int64) {
+		e.Valid = false
+		return makeParseError(prefix, str, e.Val)
+	}
+	if !i.Valid {
+		e.Valid = false
+		return nil
+	}
+	return e.set(prefix, T(i.Int64))
+}
+
+// assign converts n to T and sets the underlying value of e like set does.
+// If n cannot be stored in a T, e becomes invalid, and a ConversionError
+// with the given prefix is returned.
+func (e *IntEnum[T]) assign(prefix string, n int64) error {
+	if int64(T(n)) != n {
+		e.Valid = false
+		return makeConversionError(prefix, n, e.Val)
+	}
+	return e.set(prefix, T(n))
+}
+
+// set sets the underlying value of e to v, and e becomes valid. If v is not
+// in the allowed set of T, e becomes invalid, and an EnumError with the given
+// prefix is returned.
+func (e *IntEnum[T]) set(prefix string, v T) error {
+	if !e.allowed(v) {
+		e.Valid = false
+		return e.enumError(prefix, v)
+	}
+	e.Val = v
+	e.Valid = true
+	return nil
+}
+
+// allowed reports whether v is in the allowed set of T.
+func (e IntEnum[T]) allowed(v T) bool {
+	return slices.Contains(v.Values(), v)
+}
+
+// enumError builds an EnumError with the given prefix for v, which is not in
+// the allowed set of T.
+func (e IntEnum[T]) enumError(prefix string, v T) error {
+	values := v.Values()
+	allowed := make([]string, len(values))
+	for i, a := range values {
+		allowed[i] = strconv.FormatInt(int64(a), 10)
+	}
+	src := strconv.FormatInt(int64(v), 10)
+	return makeEnumError(prefix, src, allowed, e.Val)
+}
@@ -0,0 +1,264 @@
+package null_test
+
+import (
+	"encoding/json"
+	"errors"
+	"null"
+	"reflect"
+	"testing"
+)
+
+type priority int8
+
+func (priority) Values() []priority {
+	return []priority{1, 2, 3}
+}
+
+func TestIntEnum_Allowed(t *testing.T) {
+	var e null.IntEnum[priority]
+	exp := []priority{1, 2, 3}
+	if !reflect.DeepEqual(exp, e.Allowed()) {
+		t.Fatalf(
+			"%s: allowed mismatch (expected %v, got %v)",
+			t.Name(), exp, e.Allowed(),
+		)
+	}
+	if e.Set("2"); e != null.IntEnumFrom[priority](2) {
+		t.Fatalf("%s: enum should equal IntEnumFrom", t.Name())
+	}
+}
+
+func TestIntEnum_ZeroField(t *testing.T) {
+	var row struct {
+		Priority null.IntEnum[priority] `json:"priority"`
+	}
+
+	err := json.Unmarshal([]byte(`{"priority":0}`), &row)
+	if !errors.As(err, new(null.EnumError)) {
+		t.Fatalf("%s: unexpected json error %v", t.Name(), err)
+	}
+	if row.Priority.Valid {
+		t.Fatalf("%s: enum should be invalid after json", t.Name())
+	}
+
+	err = row.Priority.Scan(int64(4))
+	if !errors.As(err, new(null.EnumError)) {
+		t.Fatalf("%s: unexpected sql error %v", t.Name(), err)
+	}
+	if row.Priority.Valid {
+		t.Fatalf("%s: enum should be invalid after scan", t.Name())
+	}
+}
+
+func TestIntEnum_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		nullable null.IntEnum[priority]
+		json     []byte
+	}{
+		{null.IntEnumFrom[priority](2), []byte("2")},
+		{null.IntEnumFrom[priority](-1), []byte("-1")},
+		{null.IntEnum[priority]{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestIntEnum_Value(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	enumErrType := reflect.TypeOf(null.EnumError{})
+
+	cases := []struct {
+		nullable null.IntEnum[priority]
+		value    interface{}
+		errType  reflect.Type
+	}{
+		{null.IntEnumFrom[priority](2), int64(2), nilType},
+		{null.IntEnum[priority]{}, nil, nilType},
+		{null.IntEnumFrom[priority](-1), nil, enumErrType},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestIntEnum_Set(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	enumErrType := reflect.TypeOf(null.EnumError{})
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		nullable null.IntEnum[priority]
+		string   string
+		valid    bool
+		value    priority
+		errType  reflect.Type
+	}{
+		{null.IntEnum[priority]{}, "2", true, 2, nilType},
+		{null.IntEnum[priority]{}, "", false, 0, nilType},
+		{null.IntEnum[priority]{}, "4", false, 0, enumErrType},
+		{null.IntEnum[priority]{}, "x", false, 0, parseErrType},
+		{null.IntEnum[priority]{}, "300", false, 0, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := c.nullable.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != c.nullable.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, c.nullable.Valid,
+			)
+		}
+		if c.nullable.Valid && c.value != c.nullable.Val {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.value, c.nullable.Val,
+			)
+		}
+	}
+
+	e := null.IntEnum[priority]{}
+	err := e.Set("4").(null.EnumError)
+	exp := []string{"1", "2", "3"}
+	if !reflect.DeepEqual(exp, err.AllowedValues) || err.SrcValue != "4" {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+}
+
+func TestIntEnum_UnmarshalText(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	enumErrType := reflect.TypeOf(null.EnumError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("3"), true, nilType},
+		{nil, false, nilType},
+		{[]byte("0"), false, enumErrType},
+		{[]byte("three"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		e := null.IntEnum[priority]{}
+		err := e.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != e.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, e.Valid,
+			)
+		}
+	}
+}
+
+func TestIntEnum_UnmarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	enumErrType := reflect.TypeOf(null.EnumError{})
+	convErrType := reflect.TypeOf(null.ConversionError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("1"), true, nilType},
+		{[]byte("null"), false, nilType},
+		{[]byte("5"), false, enumErrType},
+		{[]byte("1.5"), false, convErrType},
+		{[]byte("257"), false, convErrType},
+		{[]byte(`"1"`), false, typeErrType},
+		{[]byte("{"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		e := null.IntEnum[priority]{}
+		err := e.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != e.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, e.Valid,
+			)
+		}
+	}
+}
+
+func TestIntEnum_Scan(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	enumErrType := reflect.TypeOf(null.EnumError{})
+	convErrType := reflect.TypeOf(null.ConversionError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{int64(2), true, nilType},
+		{nil, false, nilType},
+		{int64(7), false, enumErrType},
+		{int64(258), false, convErrType},
+		{"2", false, typeErrType},
+	}
+
+	for n, c := range cases {
+		e := null.IntEnum[priority]{}
+		err := e.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != e.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, e.Valid,
+			)
+		}
+	}
+}
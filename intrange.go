@@ -0,0 +1,319 @@
+package null
+
+import (
+	"bytes"
+	"cmp"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+// IntRange implements a nullable range of int64 values, meant to be used with
+// PostgreSQL int4range and int8range columns. It is represented in text and
+// SQL by a PostgreSQL range literal, such as [1,10) or empty, and in JSON by
+// an object such as {"lower":1,"upper":10,"lower_inc":true,"upper_inc":false}
+// or {"empty":true}.
+type IntRange struct {
+	// Lower holds the lower bound of the range. If Lower is invalid, the
+	// range has no lower bound.
+	Lower Int64
+
+	// Upper holds the upper bound of the range. If Upper is invalid, the
+	// range has no upper bound.
+	Upper Int64
+
+	// LowerInc is true if the lower bound is inclusive. It is ignored if
+	// Lower is invalid.
+	LowerInc bool
+
+	// UpperInc is true if the upper bound is inclusive. It is ignored if
+	// Upper is invalid.
+	UpperInc bool
+
+	// Empty is true if the range holds no values, in which case the bounds
+	// are ignored.
+	Empty bool
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// IntRangeFrom creates a valid IntRange holding the values from lower,
+// inclusive, to upper, exclusive, like the canonical form of PostgreSQL
+// integer ranges. An invalid bound makes the range unbounded on that side.
+// If lower is greater than upper, the returned IntRange is invalid, since
+// PostgreSQL rejects such ranges.
+func IntRangeFrom(lower, upper Int64) IntRange {
+	r := IntRange{
+		Lower:    lower,
+		Upper:    upper,
+		LowerInc: true,
+	}
+	r.Valid = r.ordered()
+	return r
+}
+
+// IsValid returns true if r is valid.
+func (r IntRange) IsValid() bool {
+	return r.Valid
+}
+
+// Invalidate makes r invalid.
+func (r *IntRange) Invalidate() {
+	r.Valid = false
+}
+
+// Interface returns the range literal of r as a string if r is valid,
+// otherwise nil.
+func (r IntRange) Interface() interface{} {
+	if r.Valid {
+		return r.literal()
+	}
+	return nil
+}
+
+// SetInterface invalidates r if v is nil, otherwise if v's type is string,
+// it behaves like Set, except that the empty string produces a ParseError.
+// If v's type is any other type, r becomes invalid, and a TypeError is
+// returned.
+func (r *IntRange) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case string:
+		if r.Set(value) != nil || value == "" {
+			r.Valid = false
+			return makeParseError("set", value, *r)
+		}
+		return nil
+	case nil:
+		r.Valid = false
+		return nil
+	default:
+		r.Valid = false
+		return makeTypeError("set", value, "string", "nil")
+	}
+}
+
+// Contains returns true if r is valid, and v lies within the bounds of r.
+// An empty range contains no values.
+func (r IntRange) Contains(v int64) bool {
+	if !r.Valid || r.Empty {
+		return false
+	}
+	b := rangeBound[int64]{val: v, ok: true, inc: true}
+	lower, upper := r.bounds()
+	return lower.below(b, cmp.Compare[int64]) && b.below(upper, cmp.Compare[int64])
+}
+
+// Overlaps returns true if r and o are both valid, and they have at least
+// one value in common.
+func (r IntRange) Overlaps(o IntRange) bool {
+	if !r.Valid || !o.Valid || r.Empty || o.Empty {
+		return false
+	}
+	rl, ru := r.bounds()
+	ol, ou := o.bounds()
+	return rl.below(ru, cmp.Compare[int64]) && ol.below(ou, cmp.Compare[int64]) &&
+		rl.below(ou, cmp.Compare[int64]) && ol.below(ru, cmp.Compare[int64])
+}
+
+// String returns a string representation of r. If r is valid,
+// it returns the PostgreSQL range literal of r, otherwise it returns
+// InvalidNullableString.
+func (r IntRange) String() string {
+	if r.Valid {
+		return r.literal()
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals r to a byte string representation. If r is valid,
+// it returns the PostgreSQL range literal of r, otherwise it returns nil.
+// err is always nil.
+func (r IntRange) MarshalText() (data []byte, err error) {
+	if r.Valid {
+		return []byte(r.literal()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes r to a JSON object holding its bounds and their
+// inclusivity if r is valid, otherwise it returns the JSON null value.
+// Missing bounds are encoded as the JSON null value, and an empty range is
+// encoded as {"empty":true}. err is always nil.
+func (r IntRange) MarshalJSON() (data []byte, err error) {
+	if !r.Valid {
+		return jNull, nil
+	}
+	if r.Empty {
+		return jEmptyRange, nil
+	}
+	lowerInc := r.LowerInc && r.Lower.Valid
+	upperInc := r.UpperInc && r.Upper.Valid
+	bytes, err := json.Marshal(rangeJSON[Int64]{
+		Lower:    r.Lower,
+		Upper:    r.Upper,
+		LowerInc: &lowerInc,
+		UpperInc: &upperInc,
+	})
+	if err != nil {
+		// this should never happen
+		panic(err)
+	}
+	return bytes, nil
+}
+
+// Value returns the PostgreSQL range literal of r if r is valid,
+// otherwise nil. err is always nil.
+func (r IntRange) Value() (v driver.Value, err error) {
+	if r.Valid {
+		return r.literal(), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates r if str is the empty string, otherwise it parses str,
+// which holds a PostgreSQL range literal, into r, and r becomes valid.
+// If str cannot be parsed, or its lower bound is greater than its upper
+// bound, r becomes invalid and a ParseError is returned.
+func (r *IntRange) Set(str string) error {
+	if str == "" {
+		r.Valid = false
+		return nil
+	}
+	if !r.parse(str) {
+		return makeParseError("parse", str, *r)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to r.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (r *IntRange) UnmarshalText(text []byte) error {
+	if r.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *r)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to r.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, r becomes invalid. If the encoded JSON data
+// represent a JSON object, r becomes valid, and its bounds are set from the
+// lower and upper members, which are unmarshaled like Int64.UnmarshalJSON
+// does. lower_inc defaults to true, and upper_inc to false. If the empty
+// member is true, r becomes an empty range. Malformed JSON, other JSON
+// types, or bounds which Int64.UnmarshalJSON rejects produce an
+// UnmarshalError. A lower bound greater than the upper bound produces a
+// ParseError.
+func (r *IntRange) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jNull) {
+		r.Valid = false
+		return nil
+	}
+	var v rangeJSON[Int64]
+	if json.Unmarshal(data, &v) != nil {
+		r.Valid = false
+		return makeUnmarshalError("json", data, *r)
+	}
+	*r = IntRange{Lower: v.Lower, Upper: v.Upper, Empty: v.Empty, Valid: true}
+	r.LowerInc = v.Lower.Valid && (v.LowerInc == nil || *v.LowerInc)
+	r.UpperInc = v.Upper.Valid && v.UpperInc != nil && *v.UpperInc
+	if !r.ordered() {
+		r.Valid = false
+		return makeParseError("json", string(data), *r)
+	}
+	return nil
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is parsed like Set does, except that the empty string produces
+// a ParseError. If obj is nil, r becomes invalid. If obj's type is any other
+// type, r becomes invalid, and a TypeError is returned.
+func (r *IntRange) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case string:
+		return r.scanString(value)
+	case []byte:
+		return r.scanString(string(value))
+	case nil:
+		r.Valid = false
+		return nil
+	default:
+		r.Valid = false
+		return makeTypeError("sql", value, "string", "[]byte", "nil")
+	}
+}
+
+// scanString parses a range returned by a database driver as a string.
+// Unlike Set, the empty string is not accepted.
+func (r *IntRange) scanString(str string) error {
+	if str == "" || !r.parse(str) {
+		r.Valid = false
+		return makeParseError("sql", str, *r)
+	}
+	return nil
+}
+
+// literal returns the PostgreSQL range literal of r.
+func (r IntRange) literal() string {
+	return formatRange(rangeLiteral{
+		lower:    strconv.FormatInt(r.Lower.Int64, 10),
+		upper:    strconv.FormatInt(r.Upper.Int64, 10),
+		hasLower: r.Lower.Valid,
+		hasUpper: r.Upper.Valid,
+		lowerInc: r.LowerInc,
+		upperInc: r.UpperInc,
+		empty:    r.Empty,
+	})
+}
+
+// parse parses str as a PostgreSQL range literal into r, and returns the
+// validity of r.
+func (r *IntRange) parse(str string) bool {
+	lit, ok := parseRange(str)
+	*r = IntRange{
+		LowerInc: lit.lowerInc,
+		UpperInc: lit.upperInc,
+		Empty:    lit.empty,
+	}
+	if ok && lit.hasLower {
+		r.Lower.Int64, r.Lower.Valid = parseRangeInt(lit.lower)
+		ok = r.Lower.Valid
+	}
+	if ok && lit.hasUpper {
+		r.Upper.Int64, r.Upper.Valid = parseRangeInt(lit.upper)
+		ok = r.Upper.Valid
+	}
+	r.Valid = ok && r.ordered()
+	return r.Valid
+}
+
+// ordered reports whether the lower bound of r does not exceed its upper
+// bound, as PostgreSQL requires. Empty and unbounded ranges are ordered.
+func (r IntRange) ordered() bool {
+	return r.Empty || !r.Lower.Valid || !r.Upper.Valid ||
+		r.Lower.Int64 <= r.Upper.Int64
+}
+
+// bounds returns the bounds of r in canonical form, where the lower bound is
+// inclusive and the upper bound is exclusive, unless that would overflow.
+func (r IntRange) bounds() (lower, upper rangeBound[int64]) {
+	lower = rangeBound[int64]{r.Lower.Int64, r.Lower.Valid, r.LowerInc}
+	upper = rangeBound[int64]{r.Upper.Int64, r.Upper.Valid, r.UpperInc}
+	if lower.ok && !lower.inc && lower.val < math.MaxInt64 {
+		lower.val, lower.inc = lower.val+1, true
+	}
+	if upper.ok && upper.inc && upper.val < math.MaxInt64 {
+		upper.val, upper.inc = upper.val+1, false
+	}
+	return lower, upper
+}
+
+// parseRangeInt parses the bound of an integer range.
+func parseRangeInt(str string) (int64, bool) {
+	v, err := strconv.ParseInt(str, 10, 64)
+	return v, err == nil
+}
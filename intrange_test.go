@@ -0,0 +1,380 @@
+package null_test
+
+import (
+	"math"
+	"null"
+	"reflect"
+	"testing"
+)
+
+// ir builds an IntRange from a PostgreSQL range literal.
+func ir(str string) null.IntRange {
+	var r null.IntRange
+	if err := r.Set(str); err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func TestIntRangeFrom(t *testing.T) {
+	r := null.IntRangeFrom(null.Int64From(1), null.Int64{})
+	if !r.Valid || !r.LowerInc || r.UpperInc || r.String() != "[1,)" {
+		t.Fatalf("%s: unexpected range %v", t.Name(), r)
+	}
+	if null.IntRangeFrom(null.Int64From(10), null.Int64From(1)).Valid {
+		t.Fatalf("%s: reversed range should be invalid", t.Name())
+	}
+}
+
+func TestIntRange_Contains(t *testing.T) {
+	cases := []struct {
+		nullable null.IntRange
+		value    int64
+		contains bool
+	}{
+		{ir("[1,5)"), 1, true},
+		{ir("[1,5)"), 4, true},
+		{ir("[1,5)"), 5, false},
+		{ir("[1,5)"), 0, false},
+		{ir("(1,5]"), 1, false},
+		{ir("(1,5]"), 5, true},
+		{ir("(,5)"), math.MinInt64, true},
+		{ir("[1,)"), math.MaxInt64, true},
+		{ir("(,)"), 0, true},
+		{ir("empty"), 0, false},
+		{null.IntRange{}, 0, false},
+	}
+
+	for n, c := range cases {
+		if c.contains != c.nullable.Contains(c.value) {
+			t.Fatalf(
+				"%s, case #%d: containment mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.contains, !c.contains,
+			)
+		}
+	}
+}
+
+func TestIntRange_Overlaps(t *testing.T) {
+	cases := []struct {
+		a, b     null.IntRange
+		overlaps bool
+	}{
+		{ir("[1,5)"), ir("[4,8)"), true},
+		{ir("[1,5)"), ir("[5,8)"), false},
+		{ir("[1,5]"), ir("[5,8)"), true},
+		{ir("[1,5)"), ir("(4,8)"), false},
+		{ir("[1,5)"), ir("(3,8)"), true},
+		{ir("(1,2)"), ir("[0,10)"), false},
+		{ir("(,0)"), ir("[-1,)"), true},
+		{ir("(,)"), ir("[3,3]"), true},
+		{ir("empty"), ir("(,)"), false},
+		{null.IntRange{}, ir("(,)"), false},
+	}
+
+	for n, c := range cases {
+		if c.overlaps != c.a.Overlaps(c.b) || c.overlaps != c.b.Overlaps(c.a) {
+			t.Fatalf(
+				"%s, case #%d: overlap mismatch (expected %t)",
+				t.Name(), n+1, c.overlaps,
+			)
+		}
+	}
+}
+
+func TestIntRange_String(t *testing.T) {
+	cases := []struct {
+		nullable null.IntRange
+		string   string
+	}{
+		{null.IntRangeFrom(null.Int64From(1), null.Int64From(5)), "[1,5)"},
+		{null.IntRangeFrom(null.Int64{}, null.Int64From(-5)), "(,-5)"},
+		{
+			null.IntRange{
+				Lower: null.Int64From(1), Upper: null.Int64From(5),
+				UpperInc: true, Valid: true,
+			},
+			"(1,5]",
+		},
+		{null.IntRange{LowerInc: true, UpperInc: true, Valid: true}, "(,)"},
+		{null.IntRange{Lower: null.Int64From(1), Empty: true, Valid: true}, "empty"},
+		{null.IntRange{}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestIntRange_MarshalText(t *testing.T) {
+	cases := []struct {
+		nullable null.IntRange
+		bytes    []byte
+	}{
+		{ir("[1,5)"), []byte("[1,5)")},
+		{null.IntRange{}, nil},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalText()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.bytes, b) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(b),
+			)
+		}
+	}
+}
+
+func TestIntRange_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		nullable null.IntRange
+		json     []byte
+	}{
+		{
+			ir("[1,5)"),
+			[]byte(`{"lower":1,"upper":5,"lower_inc":true,"upper_inc":false}`),
+		},
+		{
+			ir("(,5]"),
+			[]byte(`{"lower":null,"upper":5,"lower_inc":false,"upper_inc":true}`),
+		},
+		{ir("empty"), []byte(`{"empty":true}`)},
+		{null.IntRange{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestIntRange_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.IntRange
+		value    interface{}
+	}{
+		{ir("[1,5)"), "[1,5)"},
+		{ir("empty"), "empty"},
+		{null.IntRange{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestIntRange_Set(t *testing.T) {
+	var r null.IntRange
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		valid   bool
+		value   null.IntRange
+		errType reflect.Type
+	}{
+		{
+			"[1,5)",
+			true,
+			null.IntRangeFrom(null.Int64From(1), null.Int64From(5)),
+			nilType,
+		},
+		{
+			"[-9223372036854775808,4294967296)",
+			true,
+			null.IntRangeFrom(
+				null.Int64From(math.MinInt64), null.Int64From(1<<32),
+			),
+			nilType,
+		},
+		{
+			`("-1","5"]`,
+			true,
+			null.IntRange{
+				Lower: null.Int64From(-1), Upper: null.Int64From(5),
+				UpperInc: true, Valid: true,
+			},
+			nilType,
+		},
+		{"[,]", true, null.IntRange{Valid: true}, nilType},
+		{" EMPTY ", true, null.IntRange{Empty: true, Valid: true}, nilType},
+		{"", false, null.IntRange{}, nilType},
+		{"[1,5", false, null.IntRange{}, parseErrType},
+		{"1,5", false, null.IntRange{}, parseErrType},
+		{"[1,5,6)", false, null.IntRange{}, parseErrType},
+		{"[1)", false, null.IntRange{}, parseErrType},
+		{"[a,5)", false, null.IntRange{}, parseErrType},
+		{"[ 1,5)", false, null.IntRange{}, parseErrType},
+		{`["1,5)`, false, null.IntRange{}, parseErrType},
+		{"[(1),5)", false, null.IntRange{}, parseErrType},
+		{"[1,99999999999999999999)", false, null.IntRange{}, parseErrType},
+		{"[10,1)", false, null.IntRange{}, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := r.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != r.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, r.Valid,
+			)
+		}
+		if r.Valid && c.value != r {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, r,
+			)
+		}
+	}
+}
+
+func TestIntRange_UnmarshalText(t *testing.T) {
+	var r null.IntRange
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("[1,5)"), true, nilType},
+		{nil, false, nilType},
+		{[]byte("[1,5"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := r.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != r.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, r.Valid,
+			)
+		}
+	}
+}
+
+func TestIntRange_UnmarshalJSON(t *testing.T) {
+	var r null.IntRange
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		value   string
+		errType reflect.Type
+	}{
+		{[]byte(`{"lower":1,"upper":5}`), true, "[1,5)", nilType},
+		{
+			[]byte(`{"lower":1,"upper":5,"lower_inc":false,"upper_inc":true}`),
+			true, "(1,5]", nilType,
+		},
+		{[]byte(`{"lower":null,"lower_inc":true}`), true, "(,)", nilType},
+		{[]byte(`{"empty":true}`), true, "empty", nilType},
+		{[]byte("null"), false, "", nilType},
+		{[]byte(`{"lower":"1"}`), false, "", unmarshalErrType},
+		{[]byte(`{"lower":1.5}`), false, "", unmarshalErrType},
+		{[]byte(`"[1,5)"`), false, "", unmarshalErrType},
+		{[]byte("x"), false, "", unmarshalErrType},
+		{[]byte(`{"lower":10,"upper":1}`), false, "", parseErrType},
+	}
+
+	for n, c := range cases {
+		err := r.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != r.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, r.Valid,
+			)
+		}
+		if r.Valid && c.value != r.String() {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %s, got %s)",
+				t.Name(), n+1, c.value, r.String(),
+			)
+		}
+	}
+}
+
+func TestIntRange_Scan(t *testing.T) {
+	var r null.IntRange
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{"[1,5)", true, nilType},
+		{[]byte("empty"), true, nilType},
+		{nil, false, nilType},
+		{"", false, parseErrType},
+		{"[1,5", false, parseErrType},
+		{int64(1), false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := r.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != r.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, r.Valid,
+			)
+		}
+	}
+}
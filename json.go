@@ -0,0 +1,250 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// JSON implements a nullable json.RawMessage, meant to be used with JSON
+// document columns such as PostgreSQL json and jsonb. An invalid JSON
+// represents SQL NULL, whereas a valid JSON may hold the JSON null literal,
+// so that the two can be told apart.
+type JSON struct {
+	// JSON holds the underlying json.RawMessage value.
+	JSON json.RawMessage
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// JSONFrom creates a valid JSON from v.
+func JSONFrom(v json.RawMessage) JSON {
+	return JSONFromPtr(&v)
+}
+
+// JSONFromPtr creates a JSON from pointer p. If p is nil,
+// the returned JSON is invalid.
+func JSONFromPtr(p *json.RawMessage) JSON {
+	if p != nil {
+		return JSON{
+			JSON:  *p,
+			Valid: true,
+		}
+	}
+	return JSON{}
+}
+
+// JSONFromZero creates a JSON from v. If v is empty,
+// the returned JSON is invalid.
+func JSONFromZero(v json.RawMessage) JSON {
+	return JSON{
+		JSON:  v,
+		Valid: len(v) > 0,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of j if j is valid,
+// otherwise returns nil.
+func (j JSON) Ptr() *json.RawMessage {
+	if j.Valid {
+		return &j.JSON
+	}
+	return nil
+}
+
+// Zero returns the underlying value of j if j is valid, otherwise returns
+// nil.
+func (j JSON) Zero() json.RawMessage {
+	if j.Valid {
+		return j.JSON
+	}
+	return nil
+}
+
+// From sets the underlying value of j to v. j becomes valid.
+func (j *JSON) From(v json.RawMessage) {
+	j.JSON = v
+	j.Valid = true
+}
+
+// FromPtr invalidates j if p is nil, otherwise it sets the underlying value
+// of j to the value pointed to by p, and j becomes valid.
+func (j *JSON) FromPtr(p *json.RawMessage) {
+	j.Valid = p != nil
+	if p != nil {
+		j.JSON = *p
+	}
+}
+
+// FromZero invalidates j if v is empty, otherwise it sets the underlying
+// value of j to v, and j becomes valid.
+func (j *JSON) FromZero(v json.RawMessage) {
+	j.JSON = v
+	j.Valid = len(v) > 0
+}
+
+// IsValid returns true if j is valid.
+func (j JSON) IsValid() bool {
+	return j.Valid
+}
+
+// Invalidate makes j invalid.
+func (j *JSON) Invalidate() {
+	j.Valid = false
+}
+
+// Interface returns the underlying value of j as json.RawMessage if j is
+// valid, otherwise nil.
+func (j JSON) Interface() interface{} {
+	if j.Valid {
+		return j.JSON
+	}
+	return nil
+}
+
+// SetInterface invalidates j if v is nil, otherwise if v's type is
+// json.RawMessage, it sets the underlying value of j to v, and j becomes
+// valid. If v's type is any other type, j becomes invalid, and a TypeError
+// is returned.
+func (j *JSON) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case json.RawMessage:
+		j.From(value)
+		return nil
+	case nil:
+		j.Valid = false
+		return nil
+	default:
+		j.Valid = false
+		return makeTypeError("set", value, "json.RawMessage", "nil")
+	}
+}
+
+// Decode decodes the underlying value of j into v, like json.Unmarshal does.
+// If j is invalid, or it holds no data, it is decoded like the JSON null
+// value. If the data cannot be decoded into v, an UnmarshalError is returned.
+func (j JSON) Decode(v interface{}) error {
+	data := j.JSON
+	if !j.Valid || len(data) == 0 {
+		data = jNull
+	}
+	if json.Unmarshal(data, v) != nil {
+		return makeUnmarshalError("json", data, v)
+	}
+	return nil
+}
+
+// String returns a string representation of j. If j is valid,
+// it returns the underlying JSON text of j, otherwise it returns
+// InvalidNullableString.
+func (j JSON) String() string {
+	if j.Valid {
+		return string(j.JSON)
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals j to a byte string representation. If j is valid,
+// it returns the underlying JSON text of j, otherwise it returns nil.
+// err is always nil.
+func (j JSON) MarshalText() (data []byte, err error) {
+	if j.Valid {
+		return j.JSON, nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON returns the underlying value of j verbatim if j is valid,
+// otherwise it returns the JSON null value. A valid j which holds no data is
+// encoded as the JSON null value as well, like json.RawMessage does.
+// err is always nil.
+func (j JSON) MarshalJSON() (data []byte, err error) {
+	if j.Valid && len(j.JSON) > 0 {
+		return j.JSON, nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying JSON text of j as a string if j is valid,
+// otherwise nil. A string is returned rather than []byte, since some drivers
+// send []byte values as binary data, which JSON columns reject.
+// err is always nil.
+func (j JSON) Value() (v driver.Value, err error) {
+	if j.Valid {
+		return string(j.JSON), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates j if str is the empty string, otherwise it sets the
+// underlying value of j to str, and j becomes valid. If str is not valid
+// JSON, j becomes invalid and a ParseError is returned.
+func (j *JSON) Set(str string) error {
+	if str == "" {
+		j.Valid = false
+		return nil
+	}
+
+	j.Valid = json.Valid([]byte(str))
+	if !j.Valid {
+		return makeParseError("parse", str, *j)
+	}
+	j.JSON = json.RawMessage(str)
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to j.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text is not valid JSON.
+func (j *JSON) UnmarshalText(text []byte) error {
+	if j.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *j)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to j.
+// j becomes valid, and the underlying value of j is set to a copy of data,
+// even if data represent the JSON null value. Malformed JSON produces an
+// UnmarshalError, and j becomes invalid.
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	j.Valid = json.Valid(data)
+	if !j.Valid {
+		return makeUnmarshalError("json", data, *j)
+	}
+	j.JSON = append(json.RawMessage{}, data...)
+	return nil
+}
+
+// Scan assigns a value from a database driver. If obj's type is []byte or
+// string holding valid JSON, j becomes valid, and the underlying value of j
+// becomes a copy of obj, so that it remains valid after the driver reuses
+// its buffer. Invalid JSON, including the empty string, produces a
+// ParseError. If obj is nil, j becomes invalid. If obj's type is any other
+// type, j becomes invalid, and a TypeError is returned.
+func (j *JSON) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case []byte:
+		return j.scanBytes(value)
+	case string:
+		return j.scanBytes([]byte(value))
+	case nil:
+		j.Valid = false
+		return nil
+	default:
+		j.Valid = false
+		return makeTypeError("sql", value, "[]byte", "string", "nil")
+	}
+}
+
+// scanBytes validates and copies a JSON document returned by a database
+// driver.
+func (j *JSON) scanBytes(data []byte) error {
+	j.Valid = json.Valid(data)
+	if !j.Valid {
+		return makeParseError("sql", string(data), *j)
+	}
+	j.JSON = append(json.RawMessage{}, data...)
+	return nil
+}
@@ -0,0 +1,343 @@
+package null_test
+
+import (
+	"encoding/json"
+	"null"
+	"reflect"
+	"testing"
+)
+
+func TestJSONFromZero(t *testing.T) {
+	cases := []struct {
+		value json.RawMessage
+		valid bool
+	}{
+		{json.RawMessage(`{"a":1}`), true},
+		{json.RawMessage("null"), true},
+		{json.RawMessage{}, false},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		j := null.JSONFromZero(c.value)
+		if c.valid != j.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, j.Valid,
+			)
+		}
+	}
+}
+
+func TestJSON_Decode(t *testing.T) {
+	type doc struct {
+		A int `json:"a"`
+	}
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		nullable null.JSON
+		value    *doc
+		errType  reflect.Type
+	}{
+		{null.JSONFrom(json.RawMessage(`{"a":1}`)), &doc{A: 1}, nilType},
+		{null.JSONFrom(json.RawMessage("null")), nil, nilType},
+		{null.JSONFrom(nil), nil, nilType},
+		{null.JSON{}, nil, nilType},
+		{null.JSON{JSON: json.RawMessage(`{"a":1}`)}, nil, nilType},
+		{null.JSONFrom(json.RawMessage(`{"a":"x"}`)), nil, unmarshalErrType},
+		{null.JSONFrom(json.RawMessage("[1]")), nil, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		v := &doc{A: -1}
+		err := c.nullable.Decode(&v)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err == nil && !reflect.DeepEqual(c.value, v) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+
+	err := null.JSONFrom(json.RawMessage("1")).Decode(new(string))
+	if e, ok := err.(null.UnmarshalError); !ok || e.DestType != "*string" {
+		t.Fatalf("%s: wrong error %v", t.Name(), err)
+	}
+}
+
+func TestJSON_String(t *testing.T) {
+	cases := []struct {
+		nullable null.JSON
+		string   string
+	}{
+		{null.JSONFrom(json.RawMessage(`{"a": 1}`)), `{"a": 1}`},
+		{null.JSONFrom(json.RawMessage("null")), "null"},
+		{null.JSON{}, "<invalid>"},
+		{null.JSON{JSON: json.RawMessage("1")}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestJSON_MarshalText(t *testing.T) {
+	cases := []struct {
+		nullable null.JSON
+		bytes    []byte
+	}{
+		{null.JSONFrom(json.RawMessage(`[1, 2]`)), []byte(`[1, 2]`)},
+		{null.JSON{}, nil},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalText()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.bytes, b) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(b),
+			)
+		}
+	}
+}
+
+func TestJSON_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		nullable null.JSON
+		json     []byte
+	}{
+		{null.JSONFrom(json.RawMessage(`{"a": [1, 2]}`)), []byte(`{"a": [1, 2]}`)},
+		{null.JSONFrom(json.RawMessage("null")), []byte("null")},
+		{null.JSONFrom(nil), []byte("null")},
+		{null.JSON{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+
+	b, err := json.Marshal(struct {
+		Doc null.JSON `json:"doc"`
+	}{null.JSONFrom(json.RawMessage(`{"a": 1}`))})
+	if err != nil || string(b) != `{"doc":{"a":1}}` {
+		t.Fatalf("%s: embedding mismatch (got '%s', %v)", t.Name(), b, err)
+	}
+}
+
+func TestJSON_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.JSON
+		value    interface{}
+	}{
+		{null.JSONFrom(json.RawMessage(`{"a":1}`)), `{"a":1}`},
+		{null.JSONFrom(json.RawMessage("null")), "null"},
+		{null.JSON{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestJSON_Set(t *testing.T) {
+	var j null.JSON
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		valid   bool
+		errType reflect.Type
+	}{
+		{`{"a":1}`, true, nilType},
+		{"null", true, nilType},
+		{" 1 ", true, nilType},
+		{"", false, nilType},
+		{"{", false, parseErrType},
+		{"x", false, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := j.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != j.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, j.Valid,
+			)
+		}
+		if j.Valid && string(j.JSON) != c.string {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, string(j.JSON),
+			)
+		}
+	}
+}
+
+func TestJSON_UnmarshalText(t *testing.T) {
+	var j null.JSON
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`"x"`), true, nilType},
+		{nil, false, nilType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := j.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != j.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, j.Valid,
+			)
+		}
+	}
+}
+
+func TestJSON_UnmarshalJSON(t *testing.T) {
+	var j null.JSON
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`{"a": [1, 2]}`), true, nilType},
+		{[]byte("null"), true, nilType},
+		{[]byte(`""`), true, nilType},
+		{nil, false, unmarshalErrType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := j.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != j.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, j.Valid,
+			)
+		}
+		if j.Valid && !reflect.DeepEqual(json.RawMessage(c.json), j.JSON) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(j.JSON),
+			)
+		}
+	}
+
+	var s struct {
+		Doc null.JSON `json:"doc"`
+	}
+	if err := json.Unmarshal([]byte(`{"doc":{"a":1}}`), &s); err != nil {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+	if !s.Doc.Valid || string(s.Doc.JSON) != `{"a":1}` {
+		t.Fatalf("%s: embedding mismatch (got %v)", t.Name(), s.Doc)
+	}
+}
+
+func TestJSON_Scan(t *testing.T) {
+	var j null.JSON
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`{"a":1}`), true, nilType},
+		{`{"a":1}`, true, nilType},
+		{"null", true, nilType},
+		{nil, false, nilType},
+		{"", false, parseErrType},
+		{[]byte("{"), false, parseErrType},
+		{int64(1), false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := j.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != j.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, j.Valid,
+			)
+		}
+	}
+
+	buf := []byte(`{"a":1}`)
+	if err := j.Scan(buf); err != nil {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+	buf[1] = 'x'
+	if string(j.JSON) != `{"a":1}` {
+		t.Fatalf("%s: scanned data is not copied", t.Name())
+	}
+}
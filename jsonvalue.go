@@ -0,0 +1,261 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// JSONValue implements a nullable T stored as a JSON document, meant to be
+// used with JSON columns such as PostgreSQL json and jsonb. Unlike Of, which
+// converts T to a plain SQL value, JSONValue encodes T with json.Marshal in
+// Value and decodes it with json.Unmarshal in Scan, so that structs, slices
+// and maps can be stored in a single column. A JSON document holding the
+// JSON null value is decoded as an invalid JSONValue.
+type JSONValue[T any] struct {
+	// Val holds the underlying T value.
+	Val T
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// JSONValueFrom creates a valid JSONValue from v.
+func JSONValueFrom[T any](v T) JSONValue[T] {
+	return JSONValueFromPtr(&v)
+}
+
+// JSONValueFromPtr creates a JSONValue from pointer p. If p is nil,
+// the returned JSONValue is invalid.
+func JSONValueFromPtr[T any](p *T) JSONValue[T] {
+	if p != nil {
+		return JSONValue[T]{
+			Val:   *p,
+			Valid: true,
+		}
+	}
+	return JSONValue[T]{}
+}
+
+// JSONValueFromZero creates a JSONValue from v. If v is the zero value of T,
+// the returned JSONValue is invalid.
+func JSONValueFromZero[T any](v T) JSONValue[T] {
+	return JSONValue[T]{
+		Val:   v,
+		Valid: !isZero(v),
+	}
+}
+
+// Ptr returns a pointer to the underlying value of j if j is valid,
+// otherwise returns nil.
+func (j JSONValue[T]) Ptr() *T {
+	if j.Valid {
+		return &j.Val
+	}
+	return nil
+}
+
+// Zero returns the underlying value of j if j is valid, otherwise
+// returns the zero value of T.
+func (j JSONValue[T]) Zero() T {
+	if j.Valid {
+		return j.Val
+	}
+	var zero T
+	return zero
+}
+
+// From sets the underlying value of j to v. j becomes valid.
+func (j *JSONValue[T]) From(v T) {
+	j.Valid = true
+	j.Val = v
+}
+
+// FromPtr invalidates j if p is nil, otherwise it sets the underlying value
+// of j to the value pointed to by p, and j becomes valid.
+func (j *JSONValue[T]) FromPtr(p *T) {
+	j.Valid = p != nil
+	if p != nil {
+		j.Val = *p
+	}
+}
+
+// FromZero invalidates j if v is the zero value of T,
+// otherwise it sets the underlying value of j to v, and j becomes valid.
+func (j *JSONValue[T]) FromZero(v T) {
+	j.Valid = !isZero(v)
+	j.Val = v
+}
+
+// IsValid returns true if j is valid.
+func (j JSONValue[T]) IsValid() bool {
+	return j.Valid
+}
+
+// Invalidate makes j invalid.
+func (j *JSONValue[T]) Invalidate() {
+	j.Valid = false
+}
+
+// Interface returns the underlying value of j as T if j is valid,
+// otherwise nil.
+func (j JSONValue[T]) Interface() interface{} {
+	if j.Valid {
+		return j.Val
+	}
+	return nil
+}
+
+// SetInterface invalidates j if v is nil, otherwise if v's type is T,
+// it sets the underlying value of j to v, and j becomes valid.
+// If v's type is any other type, j becomes invalid, and a TypeError is
+// returned.
+func (j *JSONValue[T]) SetInterface(v interface{}) error {
+	if v == nil {
+		j.Valid = false
+		return nil
+	}
+	value, ok := v.(T)
+	if !ok {
+		j.Valid = false
+		return makeTypeError("set", v, typeName(j.Val), "nil")
+	}
+	j.Val = value
+	j.Valid = true
+	return nil
+}
+
+// String returns a string representation of j. If j is valid,
+// it returns the underlying value of j encoded with json.Marshal, or
+// formatted with fmt.Sprint if it cannot be encoded, otherwise it returns
+// InvalidNullableString.
+func (j JSONValue[T]) String() string {
+	if !j.Valid {
+		return InvalidNullableString
+	}
+	bytes, err := json.Marshal(j.Val)
+	if err != nil {
+		return fmt.Sprint(j.Val)
+	}
+	return string(bytes)
+}
+
+// MarshalText marshals j to a byte string representation. If j is valid,
+// it encodes the underlying value of j with json.Marshal, otherwise it
+// returns nil. If the underlying value of j cannot be encoded, a
+// MarshalError is returned.
+func (j JSONValue[T]) MarshalText() (data []byte, err error) {
+	if j.Valid {
+		return j.marshal("text")
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of j with json.Marshal if j is
+// valid, so that T is embedded in the encoded document rather than
+// represented as a string, otherwise it returns the JSON null value.
+// If the underlying value of j cannot be encoded, a MarshalError is
+// returned.
+func (j JSONValue[T]) MarshalJSON() (data []byte, err error) {
+	if j.Valid {
+		return j.marshal("json")
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of j encoded with json.Marshal as a
+// string if j is valid, otherwise nil. If the underlying value of j cannot
+// be encoded, a MarshalError is returned.
+func (j JSONValue[T]) Value() (v driver.Value, err error) {
+	if j.Valid {
+		bytes, err := j.marshal("sql")
+		if err != nil {
+			return nil, err
+		}
+		return string(bytes), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates j if str is the empty string, otherwise it decodes str
+// with json.Unmarshal into the underlying value of j, and j becomes valid,
+// unless str holds the JSON null value. If str cannot be decoded into a T,
+// j becomes invalid and a ParseError is returned.
+func (j *JSONValue[T]) Set(str string) error {
+	if str == "" {
+		j.Valid = false
+		return nil
+	}
+
+	if j.unmarshal("parse", []byte(str)) != nil {
+		return makeParseError("parse", str, j.Val)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to j.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be decoded.
+func (j *JSONValue[T]) UnmarshalText(text []byte) error {
+	if j.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, j.Val)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to j.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, j becomes invalid. Otherwise, the encoded
+// JSON data is decoded with json.Unmarshal into a new T, which becomes the
+// underlying value of j, and j becomes valid. Malformed JSON, or JSON that
+// cannot be decoded into a T, produces an UnmarshalError.
+func (j *JSONValue[T]) UnmarshalJSON(data []byte) error {
+	return j.unmarshal("json", data)
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is decoded like UnmarshalJSON does, except that the prefix of
+// the returned UnmarshalError is "sql". If obj is nil, j becomes invalid.
+// If obj's type is any other type, j becomes invalid, and a TypeError is
+// returned.
+func (j *JSONValue[T]) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case []byte:
+		return j.unmarshal("sql", value)
+	case string:
+		return j.unmarshal("sql", []byte(value))
+	case nil:
+		j.Valid = false
+		return nil
+	default:
+		j.Valid = false
+		return makeTypeError("sql", value, "[]byte", "string", "nil")
+	}
+}
+
+// marshal encodes the underlying value of j with json.Marshal, and returns
+// a MarshalError with the given prefix if it cannot be encoded.
+func (j JSONValue[T]) marshal(prefix string) ([]byte, error) {
+	bytes, err := json.Marshal(j.Val)
+	if err != nil {
+		return nil, makeMarshalError(prefix, j)
+	}
+	return bytes, nil
+}
+
+// unmarshal decodes data with json.Unmarshal into a new T, which becomes the
+// underlying value of j. j becomes invalid if data holds the JSON null value,
+// and an UnmarshalError with the given prefix and the name of T is returned
+// if data cannot be decoded.
+func (j *JSONValue[T]) unmarshal(prefix string, data []byte) error {
+	var v T
+	if json.Unmarshal(data, &v) != nil {
+		j.Valid = false
+		return makeUnmarshalError(prefix, data, v)
+	}
+	j.Val = v
+	j.Valid = !bytes.Equal(bytes.TrimSpace(data), jNull)
+	return nil
+}
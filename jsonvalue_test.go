@@ -0,0 +1,318 @@
+package null_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"null"
+	"reflect"
+	"testing"
+)
+
+// point is a struct stored as a JSON document throughout the tests.
+type point struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+func TestJSONValueFromZero(t *testing.T) {
+	cases := []struct {
+		value point
+		valid bool
+	}{
+		{point{1, 2}, true},
+		{point{}, false},
+	}
+
+	for n, c := range cases {
+		j := null.JSONValueFromZero(c.value)
+		if c.valid != j.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, j.Valid,
+			)
+		}
+	}
+}
+
+func TestJSONValue_String(t *testing.T) {
+	cases := []struct {
+		nullable interface{ String() string }
+		string   string
+	}{
+		{null.JSONValueFrom(point{1, 2}), `{"x":1,"y":2}`},
+		{null.JSONValueFrom([]string{"a"}), `["a"]`},
+		{null.JSONValue[point]{}, "<invalid>"},
+		{null.JSONValue[point]{Val: point{1, 2}}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestJSONValue_MarshalText(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable interface{ MarshalText() ([]byte, error) }
+		bytes    []byte
+		errType  reflect.Type
+	}{
+		{null.JSONValueFrom(point{1, 2}), []byte(`{"x":1,"y":2}`), nilType},
+		{null.JSONValue[point]{}, nil, nilType},
+		{null.JSONValueFrom(make(chan int)), nil, marshalErrType},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalText()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.bytes, b) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(b),
+			)
+		}
+	}
+}
+
+func TestJSONValue_MarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable interface{ MarshalJSON() ([]byte, error) }
+		json     []byte
+		errType  reflect.Type
+	}{
+		{null.JSONValueFrom(point{1, 2}), []byte(`{"x":1,"y":2}`), nilType},
+		{null.JSONValueFrom(map[string]int{"a": 1}), []byte(`{"a":1}`), nilType},
+		{null.JSONValueFrom("foo"), []byte(`"foo"`), nilType},
+		{null.JSONValue[point]{}, []byte("null"), nilType},
+		{null.JSONValueFrom(make(chan int)), nil, marshalErrType},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+
+	b, err := json.Marshal(struct {
+		P null.JSONValue[point] `json:"p"`
+	}{null.JSONValueFrom(point{1, 2})})
+	if err != nil || string(b) != `{"p":{"x":1,"y":2}}` {
+		t.Fatalf("%s: embedding mismatch (got '%s', %v)", t.Name(), b, err)
+	}
+}
+
+func TestJSONValue_Value(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable interface {
+			Value() (driver.Value, error)
+		}
+		value   interface{}
+		errType reflect.Type
+	}{
+		{null.JSONValueFrom(point{1, 2}), `{"x":1,"y":2}`, nilType},
+		{null.JSONValueFrom([]int(nil)), "null", nilType},
+		{null.JSONValue[point]{}, nil, nilType},
+		{null.JSONValueFrom(make(chan int)), nil, marshalErrType},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestJSONValue_Set(t *testing.T) {
+	var j null.JSONValue[point]
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		valid   bool
+		value   point
+		errType reflect.Type
+	}{
+		{`{"x":1,"y":2}`, true, point{1, 2}, nilType},
+		{`{"y":3}`, true, point{0, 3}, nilType},
+		{"null", false, point{}, nilType},
+		{"", false, point{}, nilType},
+		{`{"x":"a"}`, false, point{}, parseErrType},
+		{"{", false, point{}, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := j.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != j.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, j.Valid,
+			)
+		}
+		if j.Valid && c.value != j.Val {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, j.Val,
+			)
+		}
+	}
+}
+
+func TestJSONValue_UnmarshalText(t *testing.T) {
+	var j null.JSONValue[point]
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`{"x":1}`), true, nilType},
+		{nil, false, nilType},
+		{[]byte("[1]"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := j.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != j.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, j.Valid,
+			)
+		}
+	}
+}
+
+func TestJSONValue_UnmarshalJSON(t *testing.T) {
+	var j null.JSONValue[point]
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`{"x":1,"y":2}`), true, nilType},
+		{[]byte("null"), false, nilType},
+		{[]byte(`"x"`), false, unmarshalErrType},
+		{nil, false, unmarshalErrType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := j.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != j.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, j.Valid,
+			)
+		}
+	}
+}
+
+func TestJSONValue_Scan(t *testing.T) {
+	var j null.JSONValue[point]
+	nilType := reflect.TypeOf(nil)
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		value   point
+		errType reflect.Type
+	}{
+		{[]byte(`{"x":1,"y":2}`), true, point{1, 2}, nilType},
+		{`{"y":3}`, true, point{0, 3}, nilType},
+		{"null", false, point{}, nilType},
+		{nil, false, point{}, nilType},
+		{"", false, point{}, unmarshalErrType},
+		{[]byte(`{"x":true}`), false, point{}, unmarshalErrType},
+		{int64(1), false, point{}, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := j.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != j.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, j.Valid,
+			)
+		}
+		if j.Valid && c.value != j.Val {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, j.Val,
+			)
+		}
+	}
+
+	err := j.Scan("[1]")
+	if e, ok := err.(null.UnmarshalError); !ok || e.DestType != "point" {
+		t.Fatalf("%s: wrong error %v", t.Name(), err)
+	}
+}
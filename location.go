@@ -0,0 +1,254 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// Location implements a nullable time.Location, represented by its IANA Time
+// Zone database name, such as Europe/Rome. Names are loaded with
+// time.LoadLocation when they are parsed by Set, UnmarshalText, UnmarshalJSON
+// and Scan.
+type Location struct {
+	// Location holds the underlying time.Location value. A nil Location is
+	// equivalent to UTC.
+	Location *time.Location
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// LocationFrom creates a Location from v. If v is nil,
+// the returned Location is invalid.
+func LocationFrom(v *time.Location) Location {
+	return Location{
+		Location: v,
+		Valid:    v != nil,
+	}
+}
+
+// LocationFromZero creates a Location from v. If v is nil or time.UTC,
+// the returned Location is invalid.
+func LocationFromZero(v *time.Location) Location {
+	return Location{
+		Location: v,
+		Valid:    v != nil && v != time.UTC,
+	}
+}
+
+// Ptr returns the underlying value of l if l is valid, otherwise returns nil.
+// A nil underlying value is returned as time.UTC.
+func (l Location) Ptr() *time.Location {
+	if l.Valid {
+		return l.loc()
+	}
+	return nil
+}
+
+// Zero returns the underlying value of l if l is valid, otherwise returns
+// time.UTC.
+func (l Location) Zero() *time.Location {
+	if l.Valid {
+		return l.loc()
+	}
+	return time.UTC
+}
+
+// From sets the underlying value of l to v, and l becomes valid if v is not
+// nil, otherwise l becomes invalid.
+func (l *Location) From(v *time.Location) {
+	*l = LocationFrom(v)
+}
+
+// FromZero invalidates l if v is nil or time.UTC, otherwise it sets the
+// underlying value of l to v, and l becomes valid.
+func (l *Location) FromZero(v *time.Location) {
+	*l = LocationFromZero(v)
+}
+
+// IsValid returns true if l is valid.
+func (l Location) IsValid() bool {
+	return l.Valid
+}
+
+// Invalidate makes l invalid.
+func (l *Location) Invalidate() {
+	l.Valid = false
+}
+
+// Interface returns the underlying value of l as *time.Location if l is
+// valid, otherwise nil.
+func (l Location) Interface() interface{} {
+	if l.Valid {
+		return l.loc()
+	}
+	return nil
+}
+
+// SetInterface invalidates l if v is nil, otherwise if v's type is
+// *time.Location, it behaves like From. If v's type is any other type,
+// l becomes invalid, and a TypeError is returned.
+func (l *Location) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case *time.Location:
+		l.From(value)
+		return nil
+	case nil:
+		l.Valid = false
+		return nil
+	default:
+		l.Valid = false
+		return makeTypeError("set", value, "*time.Location", "nil")
+	}
+}
+
+// String returns a string representation of l. If l is valid,
+// it returns the name of the underlying value of l, otherwise it returns
+// InvalidNullableString.
+func (l Location) String() string {
+	if l.Valid {
+		return l.loc().String()
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals l to a byte string representation. If l is valid,
+// it returns the name of the underlying value of l, otherwise it returns nil.
+// err is always nil.
+func (l Location) MarshalText() (data []byte, err error) {
+	if l.Valid {
+		return []byte(l.loc().String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the name of the underlying value of l to a JSON string
+// if l is valid, otherwise it returns the JSON null value. err is always nil.
+func (l Location) MarshalJSON() (data []byte, err error) {
+	if l.Valid {
+		bytes, err := json.Marshal(l.loc().String())
+		if err != nil {
+			// this should never happen
+			panic(err)
+		}
+		return bytes, nil
+	}
+	return jNull, nil
+}
+
+// Value returns the name of the underlying value of l if l is valid,
+// otherwise nil. err is always nil.
+func (l Location) Value() (v driver.Value, err error) {
+	if l.Valid {
+		return l.loc().String(), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates l if str is the empty string, otherwise it loads the
+// location named str with time.LoadLocation into the underlying value of l,
+// and l becomes valid. If the location cannot be loaded, l becomes invalid
+// and a ParseError is returned.
+func (l *Location) Set(str string) error {
+	if str == "" {
+		l.Valid = false
+		return nil
+	}
+
+	if !l.load(str) {
+		return makeParseError("parse", str, *l)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to l.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be loaded.
+func (l *Location) UnmarshalText(text []byte) error {
+	if l.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *l)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to l.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, l becomes invalid. If the encoded JSON data
+// represent a JSON string which Set accepts, l becomes valid, and the
+// underlying value of l is set accordingly, otherwise a ParseError is
+// returned. Other JSON types produce a TypeError. Malformed JSON produces an
+// UnmarshalError.
+func (l *Location) UnmarshalJSON(data []byte) error {
+	var obj interface{}
+	if json.Unmarshal(data, &obj) != nil {
+		l.Valid = false
+		return makeUnmarshalError("json", data, *l)
+	}
+	switch value := obj.(type) {
+	case string:
+		if l.Set(value) != nil || value == "" {
+			l.Valid = false
+			return makeParseError("parse", value, *l)
+		}
+		return nil
+	case nil:
+		l.Valid = false
+		return nil
+	default:
+		l.Valid = false
+		return makeTypeError("json", value, "string", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is loaded like Set does, except that the empty string produces
+// a ParseError. If obj is nil, l becomes invalid. If obj's type is any other
+// type, l becomes invalid, and a TypeError is returned.
+func (l *Location) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case string:
+		return l.scanString(value)
+	case []byte:
+		return l.scanString(string(value))
+	case nil:
+		l.Valid = false
+		return nil
+	default:
+		l.Valid = false
+		return makeTypeError("sql", value, "string", "[]byte", "nil")
+	}
+}
+
+// scanString loads a location whose name is returned by a database driver
+// as a string. Unlike Set, the empty string is not accepted.
+func (l *Location) scanString(str string) error {
+	if str == "" || !l.load(str) {
+		l.Valid = false
+		return makeParseError("sql", str, *l)
+	}
+	return nil
+}
+
+// load sets the underlying value of l to the location named str, loaded
+// with time.LoadLocation, and returns the validity of l. "Local" is
+// rejected, since it names the time zone of the host rather than an IANA
+// time zone.
+func (l *Location) load(str string) bool {
+	if str == "Local" {
+		l.Location, l.Valid = nil, false
+		return false
+	}
+	v, err := time.LoadLocation(str)
+	l.Location, l.Valid = v, err == nil
+	return l.Valid
+}
+
+// loc returns the underlying value of l, where nil becomes time.UTC.
+func (l Location) loc() *time.Location {
+	if l.Location == nil {
+		return time.UTC
+	}
+	return l.Location
+}
@@ -0,0 +1,277 @@
+package null_test
+
+import (
+	"null"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLocationFromZero(t *testing.T) {
+	rome, _ := time.LoadLocation("Europe/Rome")
+
+	cases := []struct {
+		value *time.Location
+		valid bool
+	}{
+		{rome, true},
+		{time.Local, true},
+		{time.UTC, false},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		l := null.LocationFromZero(c.value)
+		if c.valid != l.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, l.Valid,
+			)
+		}
+	}
+}
+
+func TestLocation_Ptr(t *testing.T) {
+	rome, _ := time.LoadLocation("Europe/Rome")
+
+	cases := []struct {
+		nullable null.Location
+		ptr      *time.Location
+	}{
+		{null.LocationFrom(rome), rome},
+		{null.Location{Valid: true}, time.UTC},
+		{null.Location{Location: rome}, nil},
+	}
+
+	for n, c := range cases {
+		if c.ptr != c.nullable.Ptr() {
+			t.Fatalf(
+				"%s, case #%d: pointer mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.ptr, c.nullable.Ptr(),
+			)
+		}
+	}
+}
+
+func TestLocation_String(t *testing.T) {
+	rome, _ := time.LoadLocation("Europe/Rome")
+
+	cases := []struct {
+		nullable null.Location
+		string   string
+	}{
+		{null.LocationFrom(rome), "Europe/Rome"},
+		{null.LocationFrom(time.UTC), "UTC"},
+		{null.Location{Valid: true}, "UTC"},
+		{null.Location{}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestLocation_MarshalJSON(t *testing.T) {
+	rome, _ := time.LoadLocation("Europe/Rome")
+
+	cases := []struct {
+		nullable null.Location
+		json     []byte
+	}{
+		{null.LocationFrom(rome), []byte(`"Europe/Rome"`)},
+		{null.Location{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestLocation_Value(t *testing.T) {
+	rome, _ := time.LoadLocation("Europe/Rome")
+
+	cases := []struct {
+		nullable null.Location
+		value    interface{}
+	}{
+		{null.LocationFrom(rome), "Europe/Rome"},
+		{null.Location{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestLocation_Set(t *testing.T) {
+	var l null.Location
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		valid   bool
+		errType reflect.Type
+	}{
+		{"Europe/Rome", true, nilType},
+		{"America/New_York", true, nilType},
+		{"UTC", true, nilType},
+		{"", false, nilType},
+		{"Mars/Olympus_Mons", false, parseErrType},
+		{"../etc/passwd", false, parseErrType},
+		{"Local", false, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := l.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != l.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, l.Valid,
+			)
+		}
+		if l.Valid && c.string != l.String() {
+			t.Fatalf(
+				"%s, case #%d: name mismatch (expected %s, got %s)",
+				t.Name(), n+1, c.string, l.String(),
+			)
+		}
+	}
+}
+
+func TestLocation_UnmarshalText(t *testing.T) {
+	var l null.Location
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("Asia/Tokyo"), true, nilType},
+		{nil, false, nilType},
+		{[]byte("Nowhere"), false, unmarshalErrType},
+		{[]byte("Local"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := l.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != l.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, l.Valid,
+			)
+		}
+	}
+}
+
+func TestLocation_UnmarshalJSON(t *testing.T) {
+	var l null.Location
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`"Europe/Rome"`), true, nilType},
+		{[]byte("null"), false, nilType},
+		{[]byte(`""`), false, parseErrType},
+		{[]byte(`"Nowhere"`), false, parseErrType},
+		{[]byte(`"Local"`), false, parseErrType},
+		{[]byte("1"), false, typeErrType},
+		{[]byte(`"Europe/Rome`), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := l.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != l.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, l.Valid,
+			)
+		}
+	}
+}
+
+func TestLocation_Scan(t *testing.T) {
+	var l null.Location
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{"Europe/Rome", true, nilType},
+		{[]byte("Asia/Tokyo"), true, nilType},
+		{nil, false, nilType},
+		{"", false, parseErrType},
+		{"Nowhere", false, parseErrType},
+		{"Local", false, parseErrType},
+		{int64(1), false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := l.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != l.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, l.Valid,
+			)
+		}
+	}
+}
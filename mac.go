@@ -0,0 +1,254 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net"
+)
+
+// MAC implements a nullable net.HardwareAddr, meant to be used with hardware
+// address columns such as PostgreSQL macaddr and macaddr8.
+type MAC struct {
+	// MAC holds the underlying net.HardwareAddr value.
+	MAC net.HardwareAddr
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// MACFrom creates a valid MAC from v.
+func MACFrom(v net.HardwareAddr) MAC {
+	return MACFromPtr(&v)
+}
+
+// MACFromPtr creates a MAC from pointer p. If p is nil,
+// the returned MAC is invalid.
+func MACFromPtr(p *net.HardwareAddr) MAC {
+	if p != nil {
+		return MAC{
+			MAC:   *p,
+			Valid: true,
+		}
+	}
+	return MAC{}
+}
+
+// MACFromZero creates a MAC from v. If v is empty,
+// the returned MAC is invalid.
+func MACFromZero(v net.HardwareAddr) MAC {
+	return MAC{
+		MAC:   v,
+		Valid: len(v) > 0,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of m if m is valid,
+// otherwise returns nil.
+func (m MAC) Ptr() *net.HardwareAddr {
+	if m.Valid {
+		return &m.MAC
+	}
+	return nil
+}
+
+// Zero returns the underlying value of m if m is valid, otherwise returns
+// nil.
+func (m MAC) Zero() net.HardwareAddr {
+	if m.Valid {
+		return m.MAC
+	}
+	return nil
+}
+
+// From sets the underlying value of m to v. m becomes valid.
+func (m *MAC) From(v net.HardwareAddr) {
+	m.MAC = v
+	m.Valid = true
+}
+
+// FromPtr invalidates m if p is nil, otherwise it sets the underlying value
+// of m to the value pointed to by p, and m becomes valid.
+func (m *MAC) FromPtr(p *net.HardwareAddr) {
+	m.Valid = p != nil
+	if p != nil {
+		m.MAC = *p
+	}
+}
+
+// FromZero invalidates m if v is empty, otherwise it sets the underlying
+// value of m to v, and m becomes valid.
+func (m *MAC) FromZero(v net.HardwareAddr) {
+	m.MAC = v
+	m.Valid = len(v) > 0
+}
+
+// IsValid returns true if m is valid.
+func (m MAC) IsValid() bool {
+	return m.Valid
+}
+
+// Invalidate makes m invalid.
+func (m *MAC) Invalidate() {
+	m.Valid = false
+}
+
+// Interface returns the underlying value of m as net.HardwareAddr if m is
+// valid, otherwise nil.
+func (m MAC) Interface() interface{} {
+	if m.Valid {
+		return m.MAC
+	}
+	return nil
+}
+
+// SetInterface invalidates m if v is nil, otherwise if v's type is
+// net.HardwareAddr, it sets the underlying value of m to v, and m becomes
+// valid. If v's type is any other type, m becomes invalid, and a TypeError
+// is returned.
+func (m *MAC) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case net.HardwareAddr:
+		m.From(value)
+		return nil
+	case nil:
+		m.Valid = false
+		return nil
+	default:
+		m.Valid = false
+		return makeTypeError("set", value, "net.HardwareAddr", "nil")
+	}
+}
+
+// String returns a string representation of m. If m is valid,
+// it formats the underlying value of m like net.HardwareAddr.String does,
+// such as 00:00:5e:00:53:01, otherwise it returns InvalidNullableString.
+func (m MAC) String() string {
+	if m.Valid {
+		return m.MAC.String()
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals m to a byte string representation. If m is valid,
+// it formats the underlying value of m like net.HardwareAddr.String does,
+// otherwise it returns nil. err is always nil.
+func (m MAC) MarshalText() (data []byte, err error) {
+	if m.Valid {
+		return []byte(m.MAC.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of m to a JSON string if m is
+// valid, otherwise it returns the JSON null value. err is always nil.
+func (m MAC) MarshalJSON() (data []byte, err error) {
+	if m.Valid {
+		return []byte(`"` + m.MAC.String() + `"`), nil
+	}
+	return jNull, nil
+}
+
+// Value returns the string representation of the underlying value of m if m
+// is valid, otherwise nil. err is always nil.
+func (m MAC) Value() (v driver.Value, err error) {
+	if m.Valid {
+		return m.MAC.String(), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates m if str is the empty string, otherwise it parses str into
+// the underlying value of m, and m becomes valid. str is an IEEE 802 MAC-48,
+// EUI-48, EUI-64 or 20-octet IP over InfiniBand link-layer address, in any
+// of the forms accepted by net.ParseMAC. If str cannot be parsed, m becomes
+// invalid and a ParseError is returned.
+func (m *MAC) Set(str string) error {
+	if str == "" {
+		m.Valid = false
+		return nil
+	}
+
+	var err error
+	m.MAC, err = net.ParseMAC(str)
+	m.Valid = err == nil
+	if err != nil {
+		return makeParseError("parse", str, *m)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to m.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (m *MAC) UnmarshalText(text []byte) error {
+	if m.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *m)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to m.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, m becomes invalid. If the encoded JSON data
+// represent a JSON string which Set accepts, m becomes valid, and the
+// underlying value of m is set accordingly, otherwise a ParseError is
+// returned. Other JSON types produce a TypeError. Malformed JSON produces an
+// UnmarshalError.
+func (m *MAC) UnmarshalJSON(data []byte) error {
+	var obj interface{}
+	if json.Unmarshal(data, &obj) != nil {
+		m.Valid = false
+		return makeUnmarshalError("json", data, *m)
+	}
+	switch value := obj.(type) {
+	case string:
+		if m.Set(value) != nil || value == "" {
+			m.Valid = false
+			return makeParseError("parse", value, *m)
+		}
+		return nil
+	case nil:
+		m.Valid = false
+		return nil
+	default:
+		m.Valid = false
+		return makeTypeError("json", value, "string", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is []byte of
+// length 6 or 8, it holds the binary form of the address, which is copied.
+// Otherwise, if obj's type is string or []byte, it is parsed like Set does,
+// except that the empty string produces a ParseError. If obj is nil,
+// m becomes invalid. If obj's type is any other type, m becomes invalid,
+// and a TypeError is returned.
+func (m *MAC) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case []byte:
+		if len(value) == 6 || len(value) == 8 {
+			m.MAC = append(net.HardwareAddr(nil), value...)
+			m.Valid = true
+			return nil
+		}
+		return m.scanString(string(value))
+	case string:
+		return m.scanString(value)
+	case nil:
+		m.Valid = false
+		return nil
+	default:
+		m.Valid = false
+		return makeTypeError("sql", value, "[]byte", "string", "nil")
+	}
+}
+
+// scanString parses an address returned by a database driver as a string.
+// Unlike Set, the empty string is not accepted.
+func (m *MAC) scanString(str string) error {
+	if m.Set(str) != nil || str == "" {
+		m.Valid = false
+		return makeParseError("sql", str, *m)
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+package null_test
+
+import (
+	"net"
+	"null"
+	"reflect"
+	"testing"
+)
+
+func TestMACFromZero(t *testing.T) {
+	cases := []struct {
+		value net.HardwareAddr
+		valid bool
+	}{
+		{net.HardwareAddr{0, 0, 0x5e, 0, 0x53, 1}, true},
+		{net.HardwareAddr{}, false},
+	}
+
+	for n, c := range cases {
+		v := null.MACFromZero(c.value)
+		if c.valid != v.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, v.Valid,
+			)
+		}
+	}
+}
+
+func TestMAC_String(t *testing.T) {
+	cases := []struct {
+		nullable null.MAC
+		string   string
+	}{
+		{null.MACFrom(net.HardwareAddr{0, 0, 0x5e, 0, 0x53, 1}), "00:00:5e:00:53:01"},
+		{null.MAC{}, "<invalid>"},
+		{null.MAC{MAC: net.HardwareAddr{0, 0, 0x5e, 0, 0x53, 1}}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestMAC_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		nullable null.MAC
+		json     []byte
+	}{
+		{null.MACFrom(net.HardwareAddr{0, 0, 0x5e, 0, 0x53, 1}), []byte(`"00:00:5e:00:53:01"`)},
+		{null.MAC{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestMAC_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.MAC
+		value    interface{}
+	}{
+		{null.MACFrom(net.HardwareAddr{0, 0, 0x5e, 0, 0x53, 1}), "00:00:5e:00:53:01"},
+		{null.MAC{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestMAC_Set(t *testing.T) {
+	var v null.MAC
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		result  string
+		errType reflect.Type
+	}{
+		{"00:00:5e:00:53:01", "00:00:5e:00:53:01", nilType},
+		{"00-00-5E-00-53-01", "00:00:5e:00:53:01", nilType},
+		{"0000.5e00.5301", "00:00:5e:00:53:01", nilType},
+		{"02:00:5e:10:00:00:00:01", "02:00:5e:10:00:00:00:01", nilType},
+		{"00:00:5e:00:53", "<invalid>", parseErrType},
+		{"", "<invalid>", nilType},
+		{"x", "<invalid>", parseErrType},
+	}
+
+	for n, c := range cases {
+		err := v.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.result != v.String() {
+			t.Fatalf(
+				"%s, case #%d: result mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.result, v.String(),
+			)
+		}
+	}
+}
+
+func TestMAC_UnmarshalText(t *testing.T) {
+	var v null.MAC
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("00:00:5e:00:53:01"), true, nilType},
+		{nil, false, nilType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := v.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != v.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, v.Valid,
+			)
+		}
+	}
+}
+
+func TestMAC_UnmarshalJSON(t *testing.T) {
+	var v null.MAC
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`"00:00:5e:00:53:01"`), true, nilType},
+		{[]byte("null"), false, nilType},
+		{[]byte(`""`), false, parseErrType},
+		{[]byte(`"x"`), false, parseErrType},
+		{[]byte("1"), false, typeErrType},
+		{nil, false, unmarshalErrType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := v.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != v.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, v.Valid,
+			)
+		}
+	}
+}
+
+func TestMAC_Scan(t *testing.T) {
+	var v null.MAC
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		result  string
+		errType reflect.Type
+	}{
+		{"00:00:5e:00:53:01", "00:00:5e:00:53:01", nilType},
+		{[]byte("00:00:5e:00:53:01"), "00:00:5e:00:53:01", nilType},
+		{[]byte{0, 0, 0x5e, 0, 0x53, 1}, "00:00:5e:00:53:01", nilType},
+		{nil, "<invalid>", nilType},
+		{"", "<invalid>", parseErrType},
+		{[]byte("x"), "<invalid>", parseErrType},
+		{int64(1), "<invalid>", typeErrType},
+	}
+
+	for n, c := range cases {
+		err := v.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.result != v.String() {
+			t.Fatalf(
+				"%s, case #%d: result mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.result, v.String(),
+			)
+		}
+	}
+}
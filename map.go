@@ -0,0 +1,263 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Map implements a nullable map[K]V, which tells apart an invalid map from a
+// valid but empty one: the former is encoded as the JSON null value, the
+// latter as an empty JSON object. It is stored in SQL as a JSON object, so
+// that it can be used with JSON columns such as PostgreSQL json and jsonb.
+// K must be a valid JSON object key type, as defined by json.Marshal.
+type Map[K comparable, V any] struct {
+	// Map holds the underlying map[K]V value.
+	Map map[K]V
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// MapFrom creates a valid Map from v.
+func MapFrom[K comparable, V any](v map[K]V) Map[K, V] {
+	return MapFromPtr(&v)
+}
+
+// MapFromPtr creates a Map from pointer p. If p is nil,
+// the returned Map is invalid.
+func MapFromPtr[K comparable, V any](p *map[K]V) Map[K, V] {
+	if p != nil {
+		return Map[K, V]{
+			Map:   *p,
+			Valid: true,
+		}
+	}
+	return Map[K, V]{}
+}
+
+// MapFromZero creates a Map from v. If v is nil, the returned Map is
+// invalid. An empty but non-nil v produces a valid Map.
+func MapFromZero[K comparable, V any](v map[K]V) Map[K, V] {
+	return Map[K, V]{
+		Map:   v,
+		Valid: v != nil,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of m if m is valid,
+// otherwise returns nil.
+func (m Map[K, V]) Ptr() *map[K]V {
+	if m.Valid {
+		return &m.Map
+	}
+	return nil
+}
+
+// Zero returns the underlying value of m if m is valid, otherwise
+// returns nil.
+func (m Map[K, V]) Zero() map[K]V {
+	if m.Valid {
+		return m.Map
+	}
+	return nil
+}
+
+// From sets the underlying value of m to v. m becomes valid.
+func (m *Map[K, V]) From(v map[K]V) {
+	m.Valid = true
+	m.Map = v
+}
+
+// FromPtr invalidates m if p is nil, otherwise it sets the underlying value
+// of m to the value pointed to by p, and m becomes valid.
+func (m *Map[K, V]) FromPtr(p *map[K]V) {
+	m.Valid = p != nil
+	if p != nil {
+		m.Map = *p
+	}
+}
+
+// FromZero invalidates m if v is nil, otherwise it sets the underlying value
+// of m to v, and m becomes valid.
+func (m *Map[K, V]) FromZero(v map[K]V) {
+	m.Valid = v != nil
+	m.Map = v
+}
+
+// IsValid returns true if m is valid.
+func (m Map[K, V]) IsValid() bool {
+	return m.Valid
+}
+
+// Invalidate makes m invalid.
+func (m *Map[K, V]) Invalidate() {
+	m.Valid = false
+}
+
+// Interface returns the underlying value of m as map[K]V if m is valid,
+// otherwise nil.
+func (m Map[K, V]) Interface() interface{} {
+	if m.Valid {
+		return m.Map
+	}
+	return nil
+}
+
+// SetInterface invalidates m if v is nil, otherwise if v's type is map[K]V,
+// it sets the underlying value of m to v, and m becomes valid.
+// If v's type is any other type, m becomes invalid, and a TypeError is
+// returned.
+func (m *Map[K, V]) SetInterface(v interface{}) error {
+	if v == nil {
+		m.Valid = false
+		return nil
+	}
+	value, ok := v.(map[K]V)
+	if !ok {
+		m.Valid = false
+		return makeTypeError("set", v, typeName(m.Map), "nil")
+	}
+	m.Map = value
+	m.Valid = true
+	return nil
+}
+
+// String returns a string representation of m. If m is valid,
+// it returns the underlying value of m encoded as a JSON object, or
+// formatted with fmt.Sprint if it cannot be encoded, otherwise it returns
+// InvalidNullableString.
+func (m Map[K, V]) String() string {
+	if !m.Valid {
+		return InvalidNullableString
+	}
+	bytes, err := m.marshal("text")
+	if err != nil {
+		return fmt.Sprint(m.Map)
+	}
+	return string(bytes)
+}
+
+// MarshalText marshals m to a byte string representation. If m is valid,
+// it encodes the underlying value of m as a JSON object, otherwise it returns
+// nil. If the entries of m cannot be encoded, a MarshalError is returned.
+func (m Map[K, V]) MarshalText() (data []byte, err error) {
+	if m.Valid {
+		return m.marshal("text")
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of m to a JSON object if m is
+// valid, even if the underlying value of m is nil, otherwise it returns the
+// JSON null value. If the entries of m cannot be encoded, a MarshalError is
+// returned.
+func (m Map[K, V]) MarshalJSON() (data []byte, err error) {
+	if m.Valid {
+		return m.marshal("json")
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of m encoded as a JSON object in a
+// string if m is valid, otherwise nil. If the entries of m cannot be
+// encoded, a MarshalError is returned.
+func (m Map[K, V]) Value() (v driver.Value, err error) {
+	if m.Valid {
+		bytes, err := m.marshal("sql")
+		if err != nil {
+			return nil, err
+		}
+		return string(bytes), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates m if str is the empty string, otherwise it decodes str,
+// which holds a JSON object, into the underlying value of m, and m becomes
+// valid, unless str holds the JSON null value. If str cannot be decoded into
+// a map[K]V, m becomes invalid and a ParseError is returned.
+func (m *Map[K, V]) Set(str string) error {
+	if str == "" {
+		m.Valid = false
+		return nil
+	}
+
+	if m.unmarshal("parse", []byte(str)) != nil {
+		return makeParseError("parse", str, m.Map)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to m.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be decoded.
+func (m *Map[K, V]) UnmarshalText(text []byte) error {
+	if m.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, m.Map)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to m.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, m becomes invalid. If the encoded JSON data
+// represent a JSON object, m becomes valid, and the underlying value of m is
+// set to a new map holding the decoded entries, which is empty but not
+// nil if the object is empty. Malformed JSON, or JSON that cannot be decoded
+// into a map[K]V, produces an UnmarshalError.
+func (m *Map[K, V]) UnmarshalJSON(data []byte) error {
+	return m.unmarshal("json", data)
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is decoded like UnmarshalJSON does, except that the prefix of
+// the returned UnmarshalError is "sql". If obj is nil, m becomes invalid.
+// If obj's type is any other type, m becomes invalid, and a TypeError is
+// returned.
+func (m *Map[K, V]) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case []byte:
+		return m.unmarshal("sql", value)
+	case string:
+		return m.unmarshal("sql", []byte(value))
+	case nil:
+		m.Valid = false
+		return nil
+	default:
+		m.Valid = false
+		return makeTypeError("sql", value, "[]byte", "string", "nil")
+	}
+}
+
+// marshal encodes the underlying value of m to a JSON object, and returns a
+// MarshalError with the given prefix if it cannot be encoded. A nil map is
+// encoded as an empty JSON object.
+func (m Map[K, V]) marshal(prefix string) ([]byte, error) {
+	v := m.Map
+	if v == nil {
+		v = map[K]V{}
+	}
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return nil, makeMarshalError(prefix, m)
+	}
+	return bytes, nil
+}
+
+// unmarshal decodes data into a new map, which becomes the underlying
+// value of m. m becomes invalid if data holds the JSON null value, and an
+// UnmarshalError with the given prefix is returned if data cannot be
+// decoded.
+func (m *Map[K, V]) unmarshal(prefix string, data []byte) error {
+	var v map[K]V
+	if json.Unmarshal(data, &v) != nil {
+		m.Valid = false
+		return makeUnmarshalError(prefix, data, v)
+	}
+	m.Map = v
+	m.Valid = !bytes.Equal(bytes.TrimSpace(data), jNull)
+	return nil
+}
@@ -0,0 +1,327 @@
+package null_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"null"
+	"reflect"
+	"testing"
+)
+
+func TestMapFromZero(t *testing.T) {
+	cases := []struct {
+		value map[string]int
+		valid bool
+	}{
+		{map[string]int{"a": 1}, true},
+		{map[string]int{}, true},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		m := null.MapFromZero(c.value)
+		if c.valid != m.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, m.Valid,
+			)
+		}
+	}
+}
+
+func TestMap_String(t *testing.T) {
+	cases := []struct {
+		nullable null.Map[string, int]
+		string   string
+	}{
+		{null.MapFrom(map[string]int{"a": 1, "b": 2}), `{"a":1,"b":2}`},
+		{null.MapFrom(map[string]int{}), "{}"},
+		{null.MapFrom[string, int](nil), "{}"},
+		{null.Map[string, int]{}, "<invalid>"},
+		{null.Map[string, int]{Map: map[string]int{"a": 1}}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestMap_MarshalText(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable interface{ MarshalText() ([]byte, error) }
+		bytes    []byte
+		errType  reflect.Type
+	}{
+		{null.MapFrom(map[string]int{"a": 1}), []byte(`{"a":1}`), nilType},
+		{null.Map[string, int]{}, nil, nilType},
+		{null.MapFrom(map[string]chan int{"a": nil}), nil, marshalErrType},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalText()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.bytes, b) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(b),
+			)
+		}
+	}
+}
+
+func TestMap_MarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable interface{ MarshalJSON() ([]byte, error) }
+		json     []byte
+		errType  reflect.Type
+	}{
+		{null.MapFrom(map[string]int{"a": 1}), []byte(`{"a":1}`), nilType},
+		{null.MapFrom(map[string]int{}), []byte("{}"), nilType},
+		{null.MapFrom[string, int](nil), []byte("{}"), nilType},
+		{null.Map[string, int]{}, []byte("null"), nilType},
+		{null.MapFrom(map[string]chan int{"a": nil}), nil, marshalErrType},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+
+	b, err := json.Marshal(struct {
+		Meta null.Map[string, int] `json:"meta"`
+		Opts null.Map[string, int] `json:"opts"`
+	}{null.MapFrom(map[string]int{}), null.Map[string, int]{}})
+	if err != nil || string(b) != `{"meta":{},"opts":null}` {
+		t.Fatalf("%s: embedding mismatch (got '%s', %v)", t.Name(), b, err)
+	}
+}
+
+func TestMap_Value(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable interface {
+			Value() (driver.Value, error)
+		}
+		value   interface{}
+		errType reflect.Type
+	}{
+		{null.MapFrom(map[string]int{"a": 1, "b": 2}), `{"a":1,"b":2}`, nilType},
+		{null.MapFrom(map[string]int{}), "{}", nilType},
+		{null.Map[string, int]{}, nil, nilType},
+		{null.MapFrom(map[string]chan int{"a": nil}), nil, marshalErrType},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestMap_Set(t *testing.T) {
+	var m null.Map[string, int]
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		valid   bool
+		value   map[string]int
+		errType reflect.Type
+	}{
+		{`{"a":1,"b":2}`, true, map[string]int{"a": 1, "b": 2}, nilType},
+		{"{}", true, map[string]int{}, nilType},
+		{"null", false, nil, nilType},
+		{"", false, nil, nilType},
+		{"[]", false, nil, parseErrType},
+		{`{"a":"b"}`, false, nil, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := m.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != m.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, m.Valid,
+			)
+		}
+		if m.Valid && !reflect.DeepEqual(c.value, m.Map) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %#v, got %#v)",
+				t.Name(), n+1, c.value, m.Map,
+			)
+		}
+	}
+}
+
+func TestMap_UnmarshalText(t *testing.T) {
+	var m null.Map[string, int]
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`{"a":1}`), true, nilType},
+		{nil, false, nilType},
+		{[]byte("1"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := m.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != m.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, m.Valid,
+			)
+		}
+	}
+}
+
+func TestMap_UnmarshalJSON(t *testing.T) {
+	var m null.Map[string, int]
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		value   map[string]int
+		errType reflect.Type
+	}{
+		{[]byte(`{"a":1,"b":2}`), true, map[string]int{"a": 1, "b": 2}, nilType},
+		{[]byte("{}"), true, map[string]int{}, nilType},
+		{[]byte("null"), false, nil, nilType},
+		{[]byte("[]"), false, nil, unmarshalErrType},
+		{nil, false, nil, unmarshalErrType},
+		{[]byte("{"), false, nil, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := m.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != m.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, m.Valid,
+			)
+		}
+		if m.Valid && !reflect.DeepEqual(c.value, m.Map) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %#v, got %#v)",
+				t.Name(), n+1, c.value, m.Map,
+			)
+		}
+	}
+
+	var v struct {
+		Meta null.Map[string, int] `json:"meta"`
+		Opts null.Map[string, int] `json:"opts"`
+	}
+	err := json.Unmarshal([]byte(`{"meta":{},"opts":null}`), &v)
+	if err != nil || !v.Meta.Valid || v.Meta.Map == nil || v.Opts.Valid {
+		t.Fatalf("%s: embedding mismatch (got %v, %v)", t.Name(), v, err)
+	}
+}
+
+func TestMap_Scan(t *testing.T) {
+	var m null.Map[string, int]
+	nilType := reflect.TypeOf(nil)
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		value   map[string]int
+		errType reflect.Type
+	}{
+		{[]byte(`{"a":1,"b":2}`), true, map[string]int{"a": 1, "b": 2}, nilType},
+		{"{}", true, map[string]int{}, nilType},
+		{"null", false, nil, nilType},
+		{nil, false, nil, nilType},
+		{"", false, nil, unmarshalErrType},
+		{"[1]", false, nil, unmarshalErrType},
+		{int64(1), false, nil, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := m.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != m.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, m.Valid,
+			)
+		}
+		if m.Valid && !reflect.DeepEqual(c.value, m.Map) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %#v, got %#v)",
+				t.Name(), n+1, c.value, m.Map,
+			)
+		}
+	}
+}
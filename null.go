@@ -116,6 +116,35 @@ empty, otherwise it will be valid and it will hold the content of "-bar".
 */
 package null
 
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strconv"
+	"time"
+)
+
+// Nullable is implemented by pointers to all the nullable types in this
+// package. It allows generic code to inspect and modify a nullable without
+// knowing its concrete type.
+type Nullable interface {
+	// IsValid returns true if the nullable is valid.
+	IsValid() bool
+
+	// Invalidate makes the nullable invalid.
+	Invalidate()
+
+	// Interface returns the underlying value of the nullable if it is
+	// valid, otherwise nil.
+	Interface() interface{}
+
+	// SetInterface invalidates the nullable if v is nil, otherwise it sets
+	// the underlying value of the nullable to v, and the nullable becomes
+	// valid. If v's type does not match the type of the underlying value,
+	// the nullable becomes invalid, and a TypeError is returned.
+	SetInterface(v interface{}) error
+}
+
 const (
 	// InvalidNullableString is returned by String methods when the nullable is
 	// not valid.
@@ -130,3 +159,50 @@ var (
 	jFalse = []byte("false")
 	jNull  = []byte("null")
 )
+
+// decodeJSONNumber unmarshals data like json.Unmarshal does into an empty
+// interface, except that JSON numbers are decoded as json.Number, so that
+// integers beyond 2^53 are not rounded. ok is false if data is malformed.
+func decodeJSONNumber(data []byte) (obj interface{}, ok bool) {
+	if !json.Valid(data) {
+		return nil, false
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return obj, dec.Decode(&obj) == nil
+}
+
+// epoch returns the count of units elapsed between the Unix epoch and t,
+// rounded towards the past. ok is false if the count does not fit in an
+// int64.
+func epoch(t time.Time, unit time.Duration) (n int64, ok bool) {
+	k := int64(time.Second / unit)
+	sec := t.Unix()
+	if sec > math.MaxInt64/k-1 || sec < math.MinInt64/k {
+		return 0, false
+	}
+	return sec*k + int64(t.Nanosecond())/int64(unit), true
+}
+
+// epochTime returns the UTC time instant n units after the Unix epoch.
+func epochTime(n int64, unit time.Duration) time.Time {
+	k := int64(time.Second / unit)
+	return time.Unix(n/k, n%k*int64(unit)).UTC()
+}
+
+// parseEpoch parses str, a decimal count of units elapsed since the Unix
+// epoch, into a UTC time instant. str may hold a fraction or an exponent,
+// in which case it is converted to float64 first. ok is false if str cannot
+// be parsed, or its value does not fit in an int64.
+func parseEpoch(str string, unit time.Duration) (t time.Time, ok bool) {
+	if n, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return epochTime(n, unit), true
+	}
+
+	f, err := strconv.ParseFloat(str, 64)
+	if err != nil || math.IsNaN(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return time.Time{}, false
+	}
+	sec, frac := math.Modf(f * float64(unit) / float64(time.Second))
+	return time.Unix(int64(sec), int64(math.Round(frac*1e9))).UTC(), true
+}
@@ -0,0 +1,170 @@
+package null_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
+	"null"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var (
+	_ null.Nullable = (*null.String)(nil)
+	_ null.Nullable = (*null.Bytes)(nil)
+	_ null.Nullable = (*null.Bool)(nil)
+	_ null.Nullable = (*null.Int)(nil)
+	_ null.Nullable = (*null.Uint)(nil)
+	_ null.Nullable = (*null.Int8)(nil)
+	_ null.Nullable = (*null.Int16)(nil)
+	_ null.Nullable = (*null.Int32)(nil)
+	_ null.Nullable = (*null.Int64)(nil)
+	_ null.Nullable = (*null.Uint8)(nil)
+	_ null.Nullable = (*null.Uint16)(nil)
+	_ null.Nullable = (*null.Uint32)(nil)
+	_ null.Nullable = (*null.Uint64)(nil)
+	_ null.Nullable = (*null.Float32)(nil)
+	_ null.Nullable = (*null.Float64)(nil)
+	_ null.Nullable = (*null.Decimal)(nil)
+	_ null.Nullable = (*null.BigInt)(nil)
+	_ null.Nullable = (*null.BigFloat)(nil)
+	_ null.Nullable = (*null.Time)(nil)
+	_ null.Nullable = (*null.UnixTime)(nil)
+	_ null.Nullable = (*null.UnixMilli)(nil)
+	_ null.Nullable = (*null.UnixNano)(nil)
+	_ null.Nullable = (*null.Date)(nil)
+	_ null.Nullable = (*null.TimeOfDay)(nil)
+	_ null.Nullable = (*null.Duration)(nil)
+	_ null.Nullable = (*null.UUID)(nil)
+	_ null.Nullable = (*null.Addr)(nil)
+	_ null.Nullable = (*null.Prefix)(nil)
+	_ null.Nullable = (*null.MAC)(nil)
+	_ null.Nullable = (*null.URL)(nil)
+	_ null.Nullable = (*null.JSON)(nil)
+	_ null.Nullable = (*null.JSONValue[[]int])(nil)
+	_ null.Nullable = (*null.Slice[int])(nil)
+	_ null.Nullable = (*null.Map[string, int])(nil)
+	_ null.Nullable = (*null.StringArray)(nil)
+	_ null.Nullable = (*null.IntArray)(nil)
+	_ null.Nullable = (*null.Float64Array)(nil)
+	_ null.Nullable = (*null.BoolArray)(nil)
+	_ null.Nullable = (*null.IntRange)(nil)
+	_ null.Nullable = (*null.TimeRange)(nil)
+	_ null.Nullable = (*null.Struct[author])(nil)
+	_ null.Nullable = (*null.Enum[status])(nil)
+	_ null.Nullable = (*null.IntEnum[priority])(nil)
+	_ null.Nullable = (*null.Location)(nil)
+	_ null.Nullable = (*null.ByteSize)(nil)
+	_ null.Nullable = (*null.Sentinel[int])(nil)
+	_ null.Nullable = (*null.Of[int])(nil)
+	_ null.Nullable = (*null.Field[null.String])(nil)
+)
+
+func TestNullable(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	now := time.Now()
+
+	cases := []struct {
+		nullable null.Nullable
+		value    interface{}
+		invalid  interface{}
+	}{
+		{&null.String{}, "foo", 1},
+		{&null.Bytes{}, []byte("foo"), "foo"},
+		{&null.Bool{}, true, "true"},
+		{&null.Int{}, -1, int64(-1)},
+		{&null.Uint{}, uint(1), 1},
+		{&null.Float32{}, float32(0.5), 0.5},
+		{&null.Float64{}, 0.5, float32(0.5)},
+		{&null.Decimal{}, "-12.50", 1.5},
+		{&null.BigInt{}, big.NewInt(-1), int64(-1)},
+		{&null.BigFloat{}, big.NewFloat(0.5), 0.5},
+		{&null.Time{}, now, "x"},
+		{&null.UnixTime{}, now, "x"},
+		{&null.UnixMilli{}, now, "x"},
+		{&null.UnixNano{}, now, "x"},
+		{&null.Date{}, time.Date(1990, 5, 1, 0, 0, 0, 0, time.UTC), "x"},
+		{&null.TimeOfDay{}, time.Date(0, 1, 1, 8, 0, 0, 0, time.UTC), "x"},
+		{&null.Duration{}, time.Second, int64(1)},
+		{&null.UUID{}, [16]byte{1}, []byte{1}},
+		{&null.Addr{}, netip.MustParseAddr("::1"), "::1"},
+		{&null.Prefix{}, netip.MustParsePrefix("::/0"), "::/0"},
+		{&null.MAC{}, net.HardwareAddr{1, 2, 3, 4, 5, 6}, []byte{1}},
+		{&null.URL{}, url.URL{Scheme: "https", Host: "example.com"}, "x"},
+		{&null.JSON{}, json.RawMessage(`{"a":1}`), `{"a":1}`},
+		{&null.JSONValue[[]int]{}, []int{1, 2}, "[1,2]"},
+		{&null.Slice[int]{}, []int{}, []int64{1}},
+		{&null.Map[string, int]{}, map[string]int{"a": 1}, map[string]int64{}},
+		{&null.StringArray{}, []null.String{null.StringFrom("a")}, []string{}},
+		{&null.IntArray{}, []null.Int64{null.Int64From(1)}, []int64{1}},
+		{&null.Float64Array{}, []null.Float64{{}}, []float64{}},
+		{&null.BoolArray{}, []null.Bool{null.BoolFrom(true)}, "{t}"},
+		{&null.IntRange{}, "[1,5)", 1},
+		{&null.TimeRange{}, "(,)", time.Time{}},
+		{&null.Struct[author]{}, author{ID: null.Int64From(1)}, 1},
+		{&null.Enum[status]{}, status("active"), "active"},
+		{&null.IntEnum[priority]{}, priority(1), 1},
+		{&null.Location{}, time.UTC, "UTC"},
+		{&null.ByteSize{}, int64(1 << 20), "1MiB"},
+		{&null.Sentinel[int]{Sentinel: -1}, 1, "x"},
+		{&null.Int8{}, int8(-1), -1},
+		{&null.Int64{}, int64(-1), -1},
+		{&null.Uint8{}, uint8(1), 1},
+		{&null.Uint64{}, uint64(1), int64(1)},
+		{&null.Of[int8]{}, int8(1), 1},
+		{&null.Field[null.String]{}, "foo", 1},
+		{&null.Field[int]{}, 1, "1"},
+	}
+
+	for n, c := range cases {
+		if c.nullable.IsValid() || c.nullable.Interface() != nil {
+			t.Fatalf("%s, case #%d: zero nullable is valid", t.Name(), n+1)
+		}
+
+		err := c.nullable.SetInterface(c.value)
+		if nilType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, nilType, reflect.TypeOf(err),
+			)
+		}
+		if !c.nullable.IsValid() {
+			t.Fatalf("%s, case #%d: nullable is not valid", t.Name(), n+1)
+		}
+		if !reflect.DeepEqual(c.value, c.nullable.Interface()) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, c.nullable.Interface(),
+			)
+		}
+
+		c.nullable.Invalidate()
+		if c.nullable.IsValid() || c.nullable.Interface() != nil {
+			t.Fatalf("%s, case #%d: nullable is valid", t.Name(), n+1)
+		}
+
+		c.nullable.SetInterface(c.value)
+		if err := c.nullable.SetInterface(nil); err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if c.nullable.IsValid() {
+			t.Fatalf("%s, case #%d: nullable is valid", t.Name(), n+1)
+		}
+
+		c.nullable.SetInterface(c.value)
+		err = c.nullable.SetInterface(c.invalid)
+		if typeErrType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, typeErrType, reflect.TypeOf(err),
+			)
+		}
+		if c.nullable.IsValid() {
+			t.Fatalf("%s, case #%d: nullable is valid", t.Name(), n+1)
+		}
+	}
+}
@@ -0,0 +1,418 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Of implements a nullable T. It offers the same methods as the concrete
+// types in this package, so that any type can be made nullable without
+// writing a dedicated wrapper. Text and SQL conversions are delegated to T if
+// T implements the relevant interface (encoding.TextMarshaler,
+// encoding.TextUnmarshaler, driver.Valuer, sql.Scanner), otherwise they are
+// supported for types whose underlying type is a string, a bool, an integer,
+// a floating point number or a []byte. The concrete types are not aliases
+// of Of, since that would rename their fields and change their behavior.
+type Of[T any] struct {
+	// Val holds the underlying T value.
+	Val T
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// From creates a valid Of from v.
+func From[T any](v T) Of[T] {
+	return FromPtr(&v)
+}
+
+// FromPtr creates an Of from pointer p. If p is nil,
+// the returned Of is invalid.
+func FromPtr[T any](p *T) Of[T] {
+	if p != nil {
+		return Of[T]{
+			Val:   *p,
+			Valid: true,
+		}
+	}
+	return Of[T]{}
+}
+
+// FromZero creates an Of from v. If v is the zero value of T,
+// the returned Of is invalid.
+func FromZero[T any](v T) Of[T] {
+	return Of[T]{
+		Val:   v,
+		Valid: !isZero(v),
+	}
+}
+
+// Ptr returns a pointer to the underlying value of o if o is valid,
+// otherwise returns nil.
+func (o Of[T]) Ptr() *T {
+	if o.Valid {
+		return &o.Val
+	}
+	return nil
+}
+
+// Zero returns the underlying value of o if o is valid, otherwise
+// returns the zero value of T.
+func (o Of[T]) Zero() T {
+	if o.Valid {
+		return o.Val
+	}
+	var zero T
+	return zero
+}
+
+// From sets the underlying value of o to v. o becomes valid.
+func (o *Of[T]) From(v T) {
+	o.Valid = true
+	o.Val = v
+}
+
+// FromPtr invalidates o if p is nil, otherwise it sets the underlying value
+// of o to the value pointed to by p, and o becomes valid.
+func (o *Of[T]) FromPtr(p *T) {
+	o.Valid = p != nil
+	if p != nil {
+		o.Val = *p
+	}
+}
+
+// FromZero invalidates o if v is the zero value of T,
+// otherwise it sets the underlying value of o to v, and o becomes valid.
+func (o *Of[T]) FromZero(v T) {
+	o.Valid = !isZero(v)
+	o.Val = v
+}
+
+// IsValid returns true if o is valid.
+func (o Of[T]) IsValid() bool {
+	return o.Valid
+}
+
+// Invalidate makes o invalid.
+func (o *Of[T]) Invalidate() {
+	o.Valid = false
+}
+
+// Interface returns the underlying value of o as T if o is valid,
+// otherwise nil.
+func (o Of[T]) Interface() interface{} {
+	if o.Valid {
+		return o.Val
+	}
+	return nil
+}
+
+// SetInterface invalidates o if v is nil, otherwise if v's type is T,
+// it sets the underlying value of o to v, and o becomes valid.
+// If v's type is any other type, o becomes invalid, and a TypeError is
+// returned.
+func (o *Of[T]) SetInterface(v interface{}) error {
+	if v == nil {
+		o.Valid = false
+		return nil
+	}
+	value, ok := v.(T)
+	if !ok {
+		o.Valid = false
+		return makeTypeError("set", v, typeName(o.Val), "nil")
+	}
+	o.Val = value
+	o.Valid = true
+	return nil
+}
+
+// String returns a string representation of o. If o is valid,
+// it returns the underlying value of o formatted with fmt.Sprint, or with
+// standard base64 encoding if it is a []byte so that Set can parse it back,
+// otherwise it returns InvalidNullableString.
+func (o Of[T]) String() string {
+	if !o.Valid {
+		return InvalidNullableString
+	}
+	if v := reflect.ValueOf(&o.Val).Elem(); v.Kind() == reflect.Slice {
+		if str, ok := formatValue(v); ok {
+			return str
+		}
+	}
+	return fmt.Sprint(o.Val)
+}
+
+// MarshalText marshals o to a byte string representation.
+// If o is valid, it marshals the underlying value of o to a byte string
+// representation, otherwise it returns nil. If the underlying value of o
+// cannot be marshaled, a MarshalError is returned.
+func (o Of[T]) MarshalText() (data []byte, err error) {
+	if !o.Valid {
+		return nil, nil
+	}
+	if m, ok := interface{}(o.Val).(encoding.TextMarshaler); ok {
+		bytes, err := m.MarshalText()
+		if err != nil {
+			return nil, makeMarshalError("text", o)
+		}
+		return bytes, nil
+	}
+	if str, ok := formatValue(reflect.ValueOf(&o.Val).Elem()); ok {
+		return []byte(str), nil
+	}
+	return nil, makeMarshalError("text", o)
+}
+
+// MarshalJSON encodes the underlying value of o with json.Marshal if o is
+// valid, otherwise it returns the JSON null value. If the underlying value
+// of o cannot be marshaled, a MarshalError is returned.
+func (o Of[T]) MarshalJSON() (data []byte, err error) {
+	if o.Valid {
+		bytes, err := json.Marshal(o.Val)
+		if err != nil {
+			return nil, makeMarshalError("json", o)
+		}
+		return bytes, nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of o converted to a driver.Value
+// with driver.DefaultParameterConverter if o is valid, otherwise nil.
+// If the conversion is not possible, a MarshalError is returned.
+func (o Of[T]) Value() (v driver.Value, err error) {
+	if o.Valid {
+		val, err := driver.DefaultParameterConverter.ConvertValue(o.Val)
+		if err != nil {
+			return nil, makeMarshalError("sql", o)
+		}
+		return val, nil
+	}
+	return nil, nil
+}
+
+// Set invalidates o if str is the empty string, otherwise it parses str into
+// the underlying value of o, and o becomes valid. If str cannot be parsed
+// into a T, o becomes invalid and a ParseError is returned.
+func (o *Of[T]) Set(str string) error {
+	if str == "" {
+		o.Valid = false
+		return nil
+	}
+
+	var ok bool
+	if u, isText := interface{}(&o.Val).(encoding.TextUnmarshaler); isText {
+		ok = u.UnmarshalText([]byte(str)) == nil
+	} else {
+		ok = parseValue(reflect.ValueOf(&o.Val).Elem(), str)
+	}
+
+	o.Valid = ok
+	if ok {
+		return nil
+	}
+	return makeParseError("parse", str, *o)
+}
+
+// UnmarshalText unmarshals from a byte string to o.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (o *Of[T]) UnmarshalText(text []byte) error {
+	if o.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *o)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to o.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, o becomes invalid. Otherwise, the encoded
+// JSON data is unmarshaled with json.Unmarshal into the underlying value
+// of o, and o becomes valid. Malformed JSON, or JSON that cannot be
+// unmarshaled into a T, produces an UnmarshalError.
+func (o *Of[T]) UnmarshalJSON(data []byte) error {
+	if !json.Valid(data) {
+		o.Valid = false
+		return makeUnmarshalError("json", data, *o)
+	}
+	if bytes.Equal(bytes.TrimSpace(data), jNull) {
+		o.Valid = false
+		return nil
+	}
+
+	o.Valid = json.Unmarshal(data, &o.Val) == nil
+	if o.Valid {
+		return nil
+	}
+	return makeUnmarshalError("json", data, *o)
+}
+
+// Scan assigns a value from a database driver. If obj is nil,
+// o becomes invalid. If T implements sql.Scanner, obj is scanned by the
+// underlying value of o, otherwise obj is converted to T. If obj's type is
+// compatible with T, and obj can be stored in a T without data loss,
+// o becomes valid, and the underlying value of o becomes the value of obj.
+// If obj cannot be stored in a T without data loss,
+// o becomes invalid, and a ConversionError is returned.
+// If obj's type is not compatible with T, o becomes invalid,
+// and a TypeError is returned.
+func (o *Of[T]) Scan(obj interface{}) error {
+	if obj == nil {
+		o.Valid = false
+		return nil
+	}
+
+	var err error
+	if s, ok := interface{}(&o.Val).(sql.Scanner); ok {
+		err = s.Scan(obj)
+	} else {
+		err = assignValue("sql", reflect.ValueOf(&o.Val).Elem(), obj)
+	}
+
+	o.Valid = err == nil
+	return err
+}
+
+// isZero reports whether v is the zero value of its type.
+func isZero(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	return !rv.IsValid() || rv.IsZero()
+}
+
+// formatValue returns the text representation of v if its kind is string,
+// bool, integer or floating point number, or if it is a []byte, which is
+// encoded with standard base64 encoding like Bytes does. ok is false for
+// other kinds.
+func formatValue(v reflect.Value) (str string, ok bool) {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), true
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return base64.StdEncoding.EncodeToString(v.Bytes()), true
+		}
+	}
+	return "", false
+}
+
+// parseValue parses str into v, which must be settable. Integers are parsed
+// in the same way as Int.Set and Uint.Set, booleans as Bool.Set, and []byte
+// values as standard base64 encoded data like Bytes.Set.
+// It returns false if str cannot be parsed, or if v's kind is not
+// supported.
+func parseValue(v reflect.Value, str string) bool {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(str)
+		return true
+	case reflect.Bool:
+		val, err := strconv.ParseBool(str)
+		v.SetBool(val)
+		return err == nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		val, err := strconv.ParseInt(str, 0, v.Type().Bits())
+		v.SetInt(val)
+		return err == nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64:
+		val, err := strconv.ParseUint(str, 0, v.Type().Bits())
+		v.SetUint(val)
+		return err == nil
+	case reflect.Float32, reflect.Float64:
+		val, err := strconv.ParseFloat(str, v.Type().Bits())
+		v.SetFloat(val)
+		return err == nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			break
+		}
+		val, err := base64.StdEncoding.DecodeString(str)
+		v.SetBytes(val)
+		return err == nil
+	}
+	return false
+}
+
+// assignValue stores src, as returned by a database driver, into v, which
+// must be settable. Integer kinds accept int64, floating point kinds accept
+// float64, string kinds and []byte kinds accept both string and []byte,
+// and []byte values are copied. Any other type is accepted only if it is
+// assignable to v's type. A ConversionError is returned if src cannot be
+// stored in v without data loss, a TypeError if src's type is not compatible.
+func assignValue(prefix string, v reflect.Value, src interface{}) error {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		if val, ok := src.(int64); ok {
+			if v.OverflowInt(val) {
+				return makeConversionError(prefix, val, v.Interface())
+			}
+			v.SetInt(val)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64:
+		if val, ok := src.(int64); ok {
+			if val < 0 || v.OverflowUint(uint64(val)) {
+				return makeConversionError(prefix, val, v.Interface())
+			}
+			v.SetUint(uint64(val))
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if val, ok := src.(float64); ok {
+			if v.OverflowFloat(val) {
+				return makeConversionError(prefix, val, v.Interface())
+			}
+			v.SetFloat(val)
+			return nil
+		}
+	case reflect.String:
+		switch val := src.(type) {
+		case string:
+			v.SetString(val)
+			return nil
+		case []byte:
+			v.SetString(string(val))
+			return nil
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			break
+		}
+		switch val := src.(type) {
+		case string:
+			v.SetBytes([]byte(val))
+			return nil
+		case []byte:
+			v.SetBytes(append([]byte{}, val...))
+			return nil
+		}
+	}
+
+	sv := reflect.ValueOf(src)
+	if sv.Type().AssignableTo(v.Type()) {
+		v.Set(sv)
+		return nil
+	}
+	return makeTypeError(prefix, src, typeName(v.Interface()), "nil")
+}
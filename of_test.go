@@ -0,0 +1,675 @@
+package null_test
+
+import (
+	"database/sql/driver"
+	"null"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type ofLevel int8
+
+func TestFrom(t *testing.T) {
+	cases := []struct {
+		literal int
+		valid   bool
+	}{
+		{0, true},
+		{1, true},
+		{-1, true},
+	}
+
+	for n, c := range cases {
+		o := null.From(c.literal)
+		if c.valid != o.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, o.Valid,
+			)
+		}
+		if !o.Valid {
+			continue
+		}
+
+		if c.literal != o.Val {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, o.Val,
+			)
+		}
+	}
+}
+
+func TestFromPtr(t *testing.T) {
+	cases := []struct {
+		ptr   *int
+		valid bool
+	}{
+		{intp(0), true},
+		{intp(1), true},
+		{intp(-1), true},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		o := null.FromPtr(c.ptr)
+		if c.valid != o.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, o.Valid,
+			)
+		}
+		if !o.Valid {
+			continue
+		}
+
+		if *c.ptr != o.Val {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, *c.ptr, o.Val,
+			)
+		}
+	}
+}
+
+func TestFromZero(t *testing.T) {
+	cases := []struct {
+		literal int
+		valid   bool
+	}{
+		{0, false},
+		{1, true},
+		{-1, true},
+	}
+
+	for n, c := range cases {
+		o := null.FromZero(c.literal)
+		if c.valid != o.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, o.Valid,
+			)
+		}
+	}
+
+	if null.FromZero(time.Time{}).Valid {
+		t.Fatalf("%s: zero time.Time is valid", t.Name())
+	}
+}
+
+func TestOf_Ptr(t *testing.T) {
+	cases := []struct {
+		nullable null.Of[int]
+	}{
+		{null.Of[int]{Val: 0, Valid: true}},
+		{null.Of[int]{Val: 1, Valid: true}},
+		{null.Of[int]{}},
+		{null.Of[int]{Val: 2, Valid: false}},
+	}
+
+	for n, c := range cases {
+		p := c.nullable.Ptr()
+		if c.nullable.Valid {
+			if p == nil {
+				t.Fatalf(
+					"%s, case #%d: nil pointer returned",
+					t.Name(), n+1,
+				)
+			}
+			if c.nullable.Val != *p {
+				t.Fatalf(
+					"%s, case #%d: literal mismatch (expected %d, got %d)",
+					t.Name(), n+1, c.nullable.Val, *p,
+				)
+			}
+		} else {
+			if p != nil {
+				t.Fatalf(
+					"%s, case #%d: non-nil pointer returned",
+					t.Name(), n+1,
+				)
+			}
+		}
+	}
+}
+
+func TestOf_Zero(t *testing.T) {
+	cases := []struct {
+		nullable null.Of[string]
+		literal  string
+	}{
+		{null.Of[string]{Val: "", Valid: true}, ""},
+		{null.Of[string]{Val: "foo", Valid: true}, "foo"},
+		{null.Of[string]{}, ""},
+		{null.Of[string]{Val: "bar", Valid: false}, ""},
+	}
+
+	for n, c := range cases {
+		s := c.nullable.Zero()
+		if c.literal != s {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.literal, s,
+			)
+		}
+	}
+}
+
+func TestOf_From(t *testing.T) {
+	var o null.Of[int]
+	cases := []struct {
+		literal int
+		valid   bool
+	}{
+		{0, true},
+		{1, true},
+		{-1, true},
+	}
+
+	for n, c := range cases {
+		o.From(c.literal)
+		if c.valid != o.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, o.Valid,
+			)
+		}
+		if c.literal != o.Val {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, o.Val,
+			)
+		}
+	}
+}
+
+func TestOf_FromPtr(t *testing.T) {
+	var o null.Of[int]
+	cases := []struct {
+		ptr   *int
+		valid bool
+	}{
+		{intp(0), true},
+		{intp(1), true},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		o.FromPtr(c.ptr)
+		if c.valid != o.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, o.Valid,
+			)
+		}
+		if !o.Valid {
+			continue
+		}
+
+		if *c.ptr != o.Val {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, *c.ptr, o.Val,
+			)
+		}
+	}
+}
+
+func TestOf_FromZero(t *testing.T) {
+	var o null.Of[int]
+	cases := []struct {
+		literal int
+		valid   bool
+	}{
+		{0, false},
+		{1, true},
+		{-1, true},
+	}
+
+	for n, c := range cases {
+		o.FromZero(c.literal)
+		if c.valid != o.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, o.Valid,
+			)
+		}
+	}
+}
+
+func TestOf_String(t *testing.T) {
+	cases := []struct {
+		nullable interface{ String() string }
+		string   string
+	}{
+		{null.Of[int]{Val: -1, Valid: true}, "-1"},
+		{null.Of[float32]{Val: 0.1, Valid: true}, "0.1"},
+		{null.Of[bool]{Val: true, Valid: true}, "true"},
+		{null.Of[time.Duration]{Val: time.Second, Valid: true}, "1s"},
+		{null.Of[[]byte]{Val: []byte("x"), Valid: true}, "eA=="},
+		{null.Of[int]{}, "<invalid>"},
+		{null.Of[int]{Val: 2, Valid: false}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestOf_MarshalText(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+	ts := time.Date(2019, 8, 4, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		nullable interface{ MarshalText() ([]byte, error) }
+		bytes    []byte
+		errType  reflect.Type
+	}{
+		{null.Of[int]{Val: -1, Valid: true}, []byte("-1"), nilType},
+		{null.Of[uint16]{Val: 10, Valid: true}, []byte("10"), nilType},
+		{null.Of[float32]{Val: 0.1, Valid: true}, []byte("0.1"), nilType},
+		{null.Of[ofLevel]{Val: 3, Valid: true}, []byte("3"), nilType},
+		{null.Of[string]{Val: "foo", Valid: true}, []byte("foo"), nilType},
+		{
+			null.Of[[]byte]{Val: []byte("abc"), Valid: true},
+			[]byte("YWJj"), nilType,
+		},
+		{
+			null.Of[time.Time]{Val: ts, Valid: true},
+			[]byte("2019-08-04T12:00:00Z"), nilType,
+		},
+		{null.Of[int]{}, nil, nilType},
+		{null.Of[[]int]{Val: []int{1}, Valid: true}, nil, marshalErrType},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalText()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if !reflect.DeepEqual(c.bytes, b) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(b),
+			)
+		}
+	}
+}
+
+func TestOf_MarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable interface{ MarshalJSON() ([]byte, error) }
+		json     []byte
+		errType  reflect.Type
+	}{
+		{null.Of[int]{Val: -1, Valid: true}, []byte("-1"), nilType},
+		{null.Of[string]{Val: "foo", Valid: true}, []byte(`"foo"`), nilType},
+		{null.Of[[]int]{Val: []int{1, 2}, Valid: true}, []byte("[1,2]"), nilType},
+		{null.Of[int]{}, []byte("null"), nilType},
+		{null.Of[string]{Val: "foo", Valid: false}, []byte("null"), nilType},
+		{null.Of[chan int]{Val: nil, Valid: true}, nil, marshalErrType},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestOf_Value(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable driver.Valuer
+		value    interface{}
+		errType  reflect.Type
+	}{
+		{null.Of[int]{Val: -1, Valid: true}, int64(-1), nilType},
+		{null.Of[uint8]{Val: 1, Valid: true}, int64(1), nilType},
+		{null.Of[float32]{Val: 0.5, Valid: true}, 0.5, nilType},
+		{null.Of[ofLevel]{Val: 2, Valid: true}, int64(2), nilType},
+		{null.Of[string]{Val: "x", Valid: true}, "x", nilType},
+		{null.Of[int]{}, nil, nilType},
+		{null.Of[uint64]{Val: 1 << 63, Valid: true}, nil, marshalErrType},
+		{null.Of[[]int]{Val: []int{}, Valid: true}, nil, marshalErrType},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if !reflect.DeepEqual(c.value, v) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestOf_Set(t *testing.T) {
+	var o null.Of[int8]
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		literal int8
+		valid   bool
+		errType reflect.Type
+	}{
+		{"0", 0, true, nilType},
+		{"-1", -1, true, nilType},
+		{"0x10", 16, true, nilType},
+		{"", 0, false, nilType},
+		{"128", 0, false, parseErrType},
+		{"x", 0, false, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := o.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			if o.Valid {
+				t.Fatalf("%s, case #%d: valid after error", t.Name(), n+1)
+			}
+			continue
+		}
+
+		if c.valid != o.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, o.Valid,
+			)
+		}
+		if !o.Valid {
+			continue
+		}
+
+		if c.literal != o.Val {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, o.Val,
+			)
+		}
+	}
+}
+
+func TestOf_SetTextUnmarshaler(t *testing.T) {
+	var o null.Of[time.Time]
+	exp := time.Date(2019, 8, 4, 12, 0, 0, 0, time.UTC)
+
+	if err := o.Set("2019-08-04T12:00:00Z"); err != nil {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+	if !o.Valid || !exp.Equal(o.Val) {
+		t.Fatalf(
+			"%s: literal mismatch (expected %v, got %v)",
+			t.Name(), exp, o.Val,
+		)
+	}
+
+	errType := reflect.TypeOf(null.ParseError{})
+	if err := o.Set("x"); errType != reflect.TypeOf(err) {
+		t.Fatalf(
+			"%s: wrong error type (expected %v, got %v)",
+			t.Name(), errType, reflect.TypeOf(err),
+		)
+	}
+}
+
+func TestOf_SetBytes(t *testing.T) {
+	var o null.Of[[]byte]
+	if err := o.Set("YWJj"); err != nil {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+	if !o.Valid || string(o.Val) != "abc" {
+		t.Fatalf(
+			"%s: literal mismatch (expected abc, got %s)",
+			t.Name(), o.Val,
+		)
+	}
+
+	text, _ := null.From([]byte{0xff, 0}).MarshalText()
+	if err := o.UnmarshalText(text); err != nil {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+	if !o.Valid || !reflect.DeepEqual([]byte{0xff, 0}, o.Val) {
+		t.Fatalf("%s: round trip mismatch (got %v)", t.Name(), o.Val)
+	}
+
+	errType := reflect.TypeOf(null.ParseError{})
+	if err := o.Set("abc"); errType != reflect.TypeOf(err) || o.Valid {
+		t.Fatalf(
+			"%s: wrong error type (expected %v, got %v)",
+			t.Name(), errType, reflect.TypeOf(err),
+		)
+	}
+}
+
+func TestOf_UnmarshalText(t *testing.T) {
+	var o null.Of[bool]
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		literal bool
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("true"), true, true, nilType},
+		{[]byte("f"), false, true, nilType},
+		{nil, false, false, nilType},
+		{[]byte(""), false, false, nilType},
+		{[]byte("x"), false, false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := o.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.valid != o.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, o.Valid,
+			)
+		}
+		if !o.Valid {
+			continue
+		}
+
+		if c.literal != o.Val {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.literal, o.Val,
+			)
+		}
+	}
+}
+
+func TestOf_UnmarshalJSON(t *testing.T) {
+	var o null.Of[int]
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		literal int
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("0"), 0, true, nilType},
+		{[]byte("-1"), -1, true, nilType},
+		{[]byte("null"), 0, false, nilType},
+		{[]byte(" null "), 0, false, nilType},
+		{[]byte("0.1"), 0, false, unmarshalErrType},
+		{[]byte(`"x"`), 0, false, unmarshalErrType},
+		{nil, 0, false, unmarshalErrType},
+		{[]byte("x"), 0, false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := o.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			if o.Valid {
+				t.Fatalf("%s, case #%d: valid after error", t.Name(), n+1)
+			}
+			continue
+		}
+
+		if c.valid != o.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, o.Valid,
+			)
+		}
+		if !o.Valid {
+			continue
+		}
+
+		if c.literal != o.Val {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, o.Val,
+			)
+		}
+	}
+}
+
+func TestOf_Scan(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	cnvErrType := reflect.TypeOf(null.ConversionError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	var i8 null.Of[int8]
+	var u null.Of[uint]
+	var f32 null.Of[float32]
+	var s null.Of[string]
+	var b null.Of[[]byte]
+	var lvl null.Of[ofLevel]
+	var ts null.Of[time.Time]
+	var ni null.Of[null.Int]
+
+	cases := []struct {
+		nullable interface {
+			Scan(interface{}) error
+		}
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{&i8, int64(-128), true, nilType},
+		{&i8, int64(128), false, cnvErrType},
+		{&i8, "x", false, typeErrType},
+		{&u, int64(1), true, nilType},
+		{&u, int64(-1), false, cnvErrType},
+		{&f32, float64(0.5), true, nilType},
+		{&f32, float64(1e300), false, cnvErrType},
+		{&s, "foo", true, nilType},
+		{&s, []byte("foo"), true, nilType},
+		{&s, int64(1), false, typeErrType},
+		{&b, []byte("foo"), true, nilType},
+		{&b, "foo", true, nilType},
+		{&lvl, int64(3), true, nilType},
+		{&ts, time.Now(), true, nilType},
+		{&ts, "x", false, typeErrType},
+		{&ni, int64(1), true, nilType},
+		{&ni, "x", false, typeErrType},
+		{&s, nil, false, nilType},
+	}
+
+	for n, c := range cases {
+		err := c.nullable.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+
+		valid := reflect.ValueOf(c.nullable).Elem().FieldByName("Valid").Bool()
+		if c.valid != valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, valid,
+			)
+		}
+	}
+}
+
+func TestOf_ScanCopiesBytes(t *testing.T) {
+	var b null.Of[[]byte]
+	src := []byte("foo")
+
+	if err := b.Scan(src); err != nil {
+		t.Fatalf("%s: unexpected error %v", t.Name(), err)
+	}
+	src[0] = 'b'
+	if string(b.Val) != "foo" {
+		t.Fatalf(
+			"%s: driver buffer is shared (expected 'foo', got '%s')",
+			t.Name(), string(b.Val),
+		)
+	}
+}
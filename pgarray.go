@@ -0,0 +1,218 @@
+package null
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// arrayElem is an element of a PostgreSQL array literal.
+type arrayElem struct {
+	// str holds the unquoted and unescaped text of the element
+	str string
+
+	// null is true if the element is an unquoted NULL
+	null bool
+}
+
+// parseArray parses str as a one-dimensional PostgreSQL array literal, such
+// as {a,NULL,"b c"}, with an optional dimension decoration like [0:1]={a,b}.
+// Elements may be double-quoted, and backslashes escape the next character.
+// dims is greater than 1 if str holds a multi-dimensional array, in which
+// case elems is nil. ok is false if str is malformed.
+func parseArray(str string) (elems []arrayElem, dims int, ok bool) {
+	s := str
+	if strings.HasPrefix(s, "[") {
+		i := strings.IndexByte(s, '=')
+		if i < 0 {
+			return nil, 0, false
+		}
+		dims = strings.Count(s[:i], "[")
+		if dims > 1 {
+			return nil, dims, false
+		}
+		s = s[i+1:]
+	}
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, 0, false
+	}
+	s = s[1 : len(s)-1]
+
+	if t := strings.TrimLeft(s, arraySpace); strings.HasPrefix(t, "{") {
+		dims = 1
+		for strings.HasPrefix(t, "{") {
+			dims++
+			t = strings.TrimLeft(t[1:], arraySpace)
+		}
+		return nil, dims, false
+	}
+	if strings.TrimLeft(s, arraySpace) == "" {
+		return []arrayElem{}, 1, true
+	}
+
+	for {
+		var elem arrayElem
+		var ok bool
+		s = strings.TrimLeft(s, arraySpace)
+		if strings.HasPrefix(s, `"`) {
+			elem.str, s, ok = parseArrayQuoted(s[1:])
+		} else {
+			elem, s, ok = parseArrayUnquoted(s)
+		}
+		if !ok {
+			return nil, 0, false
+		}
+		elems = append(elems, elem)
+
+		s = strings.TrimLeft(s, arraySpace)
+		if s == "" {
+			return elems, 1, true
+		}
+		if s[0] != ',' {
+			return nil, 0, false
+		}
+		s = s[1:]
+	}
+}
+
+// arraySpace holds the characters which PostgreSQL ignores around the
+// elements of an array literal.
+const arraySpace = " \t\n\r\v\f"
+
+// parseArrayQuoted parses a double-quoted element, whose opening quote has
+// already been consumed, and returns its text and the rest of s.
+func parseArrayQuoted(s string) (str, rest string, ok bool) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+			if i == len(s) {
+				return "", "", false
+			}
+			b.WriteByte(s[i])
+		case '"':
+			return b.String(), s[i+1:], true
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", "", false
+}
+
+// parseArrayUnquoted parses an unquoted element, which ends at the next
+// unescaped comma, and returns it and the rest of s. Trailing spaces are
+// ignored, unless they are escaped.
+func parseArrayUnquoted(s string) (elem arrayElem, rest string, ok bool) {
+	var b strings.Builder
+	escaped := false
+	end := 0 // length of b up to the last character that is not a space
+	i := 0
+	for ; i < len(s) && s[i] != ','; i++ {
+		switch c := s[i]; {
+		case c == '\\':
+			i++
+			if i == len(s) {
+				return arrayElem{}, "", false
+			}
+			escaped = true
+			b.WriteByte(s[i])
+			end = b.Len()
+		case c == '"' || c == '{' || c == '}':
+			return arrayElem{}, "", false
+		default:
+			b.WriteByte(c)
+			if !strings.ContainsRune(arraySpace, rune(c)) {
+				end = b.Len()
+			}
+		}
+	}
+	str := b.String()[:end]
+	if str == "" {
+		return arrayElem{}, "", false
+	}
+	elem.str = str
+	elem.null = !escaped && strings.EqualFold(str, "NULL")
+	return elem, s[i:], true
+}
+
+// formatArray returns the PostgreSQL array literal holding elems. Elements
+// are double-quoted if they are empty, if they would be taken for NULL, or
+// if they hold spaces or characters which are special in array literals.
+func formatArray(elems []arrayElem) string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, elem := range elems {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		switch {
+		case elem.null:
+			b.WriteString("NULL")
+		case elem.str == "" || strings.EqualFold(elem.str, "NULL") ||
+			strings.ContainsAny(elem.str, `{}",\`+arraySpace):
+			b.WriteByte('"')
+			for j := 0; j < len(elem.str); j++ {
+				if c := elem.str[j]; c == '"' || c == '\\' {
+					b.WriteByte('\\')
+				}
+				b.WriteByte(elem.str[j])
+			}
+			b.WriteByte('"')
+		default:
+			b.WriteString(elem.str)
+		}
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// parseArrayOf parses str as a one-dimensional PostgreSQL array literal,
+// and converts its elements with conv, which returns false if an element
+// cannot be converted. A DimensionError is returned if str holds a
+// multi-dimensional array, and a ParseError if str is malformed or an element
+// cannot be converted. prefix and dest are used to build the errors.
+func parseArrayOf[E any](
+	prefix, str string, dest interface{}, conv func(arrayElem) (E, bool),
+) ([]E, error) {
+	elems, dims, ok := parseArray(str)
+	if dims > 1 {
+		return nil, makeDimensionError(prefix, str, dims, dest)
+	}
+	if !ok {
+		return nil, makeParseError(prefix, str, dest)
+	}
+	v := make([]E, len(elems))
+	for i, elem := range elems {
+		if v[i], ok = conv(elem); !ok {
+			return nil, makeParseError(prefix, str, dest)
+		}
+	}
+	return v, nil
+}
+
+// formatArrayOf returns the PostgreSQL array literal holding the elements of
+// v, which are converted to text by conv. conv returns false for NULL
+// elements.
+func formatArrayOf[E any](v []E, conv func(E) (string, bool)) string {
+	elems := make([]arrayElem, len(v))
+	for i, e := range v {
+		str, ok := conv(e)
+		elems[i] = arrayElem{str: str, null: !ok}
+	}
+	return formatArray(elems)
+}
+
+// formatArrayFloat formats f like PostgreSQL formats float8 values.
+func formatArrayFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	case math.IsNaN(f):
+		return "NaN"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,181 @@
+package null
+
+import (
+	"strings"
+)
+
+// rangeLiteral holds the parts of a PostgreSQL range literal, such as
+// [1,5) or empty.
+type rangeLiteral struct {
+	// lower and upper hold the unquoted and unescaped text of the bounds
+	lower, upper string
+
+	// hasLower and hasUpper are false if the range is unbounded on the
+	// related side
+	hasLower, hasUpper bool
+
+	// lowerInc and upperInc are true if the related bound is inclusive
+	lowerInc, upperInc bool
+
+	// empty is true if the range holds no values
+	empty bool
+}
+
+// parseRange parses str as a PostgreSQL range literal. A bound may be
+// double-quoted, and backslashes escape the next character. A missing bound
+// makes the range unbounded on that side, in which case the bound is
+// exclusive. ok is false if str is malformed.
+func parseRange(str string) (r rangeLiteral, ok bool) {
+	s := strings.TrimSpace(str)
+	if strings.EqualFold(s, "empty") {
+		return rangeLiteral{empty: true}, true
+	}
+	if len(s) < 3 {
+		return rangeLiteral{}, false
+	}
+	switch s[0] {
+	case '[':
+		r.lowerInc = true
+	case '(':
+	default:
+		return rangeLiteral{}, false
+	}
+	switch s[len(s)-1] {
+	case ']':
+		r.upperInc = true
+	case ')':
+	default:
+		return rangeLiteral{}, false
+	}
+
+	var rest string
+	r.lower, r.hasLower, rest, ok = parseRangeBound(s[1 : len(s)-1])
+	if !ok || !strings.HasPrefix(rest, ",") {
+		return rangeLiteral{}, false
+	}
+	r.upper, r.hasUpper, rest, ok = parseRangeBound(rest[1:])
+	if !ok || rest != "" {
+		return rangeLiteral{}, false
+	}
+	r.lowerInc = r.lowerInc && r.hasLower
+	r.upperInc = r.upperInc && r.hasUpper
+	return r, true
+}
+
+// parseRangeBound parses a bound, which ends at the first unquoted comma,
+// and returns its text and the rest of s. present is false if the bound is
+// missing.
+func parseRangeBound(
+	s string,
+) (str string, present bool, rest string, ok bool) {
+	var b strings.Builder
+	quoted := false
+	i := 0
+	for ; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\':
+			i++
+			if i == len(s) {
+				return "", false, "", false
+			}
+			b.WriteByte(s[i])
+		case quoted && c == '"' && i+1 < len(s) && s[i+1] == '"':
+			i++
+			b.WriteByte('"')
+		case c == '"':
+			quoted = !quoted
+		case !quoted && c == ',':
+			return b.String(), present, s[i:], true
+		case !quoted && strings.IndexByte("()[]", c) >= 0:
+			return "", false, "", false
+		default:
+			b.WriteByte(c)
+		}
+		present = true
+	}
+	if quoted {
+		return "", false, "", false
+	}
+	return b.String(), present, "", true
+}
+
+// formatRange returns the PostgreSQL range literal holding r. Bounds are
+// double-quoted if they are empty, or if they hold spaces or characters
+// which are special in range literals.
+func formatRange(r rangeLiteral) string {
+	if r.empty {
+		return "empty"
+	}
+	var b strings.Builder
+	if r.lowerInc && r.hasLower {
+		b.WriteByte('[')
+	} else {
+		b.WriteByte('(')
+	}
+	if r.hasLower {
+		writeRangeBound(&b, r.lower)
+	}
+	b.WriteByte(',')
+	if r.hasUpper {
+		writeRangeBound(&b, r.upper)
+	}
+	if r.upperInc && r.hasUpper {
+		b.WriteByte(']')
+	} else {
+		b.WriteByte(')')
+	}
+	return b.String()
+}
+
+// writeRangeBound writes the bound str to b, quoting it if needed.
+func writeRangeBound(b *strings.Builder, str string) {
+	if str != "" && !strings.ContainsAny(str, `"\,()[] `+"\t\n\r\v\f") {
+		b.WriteString(str)
+		return
+	}
+	b.WriteByte('"')
+	for i := 0; i < len(str); i++ {
+		if c := str[i]; c == '"' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(str[i])
+	}
+	b.WriteByte('"')
+}
+
+// rangeBound is a bound of a range of T values, used to compare ranges.
+type rangeBound[T any] struct {
+	// val holds the value of the bound
+	val T
+
+	// ok is false if the range is unbounded on the side of the bound
+	ok bool
+
+	// inc is true if the bound is inclusive
+	inc bool
+}
+
+// below reports whether lower bound l does not exceed upper bound u,
+// that is, whether a value exists between l and u, according to cmp.
+func (l rangeBound[T]) below(u rangeBound[T], cmp func(a, b T) int) bool {
+	if !l.ok || !u.ok {
+		return true
+	}
+	c := cmp(l.val, u.val)
+	return c < 0 || c == 0 && l.inc && u.inc
+}
+
+// rangeJSON is the JSON representation of a range whose bounds are T
+// values. An invalid bound is encoded as the JSON null value, and it makes
+// the range unbounded on that side.
+type rangeJSON[T any] struct {
+	Lower    T     `json:"lower"`
+	Upper    T     `json:"upper"`
+	LowerInc *bool `json:"lower_inc,omitempty"`
+	UpperInc *bool `json:"upper_inc,omitempty"`
+	Empty    bool  `json:"empty,omitempty"`
+}
+
+// jEmptyRange is the JSON representation of an empty range.
+var jEmptyRange = []byte(`{"empty":true}`)
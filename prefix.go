@@ -0,0 +1,259 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net/netip"
+)
+
+// Prefix implements a nullable netip.Prefix, meant to be used with network
+// columns such as PostgreSQL cidr and inet.
+type Prefix struct {
+	// Prefix holds the underlying netip.Prefix value.
+	Prefix netip.Prefix
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// PrefixFrom creates a valid Prefix from v.
+func PrefixFrom(v netip.Prefix) Prefix {
+	return PrefixFromPtr(&v)
+}
+
+// PrefixFromPtr creates a Prefix from pointer p. If p is nil,
+// the returned Prefix is invalid.
+func PrefixFromPtr(p *netip.Prefix) Prefix {
+	if p != nil {
+		return Prefix{
+			Prefix: *p,
+			Valid:  true,
+		}
+	}
+	return Prefix{}
+}
+
+// PrefixFromZero creates a Prefix from v. If v is the zero netip.Prefix,
+// the returned Prefix is invalid.
+func PrefixFromZero(v netip.Prefix) Prefix {
+	return Prefix{
+		Prefix: v,
+		Valid:  v.IsValid(),
+	}
+}
+
+// Ptr returns a pointer to the underlying value of p if p is valid,
+// otherwise returns nil.
+func (p Prefix) Ptr() *netip.Prefix {
+	if p.Valid {
+		return &p.Prefix
+	}
+	return nil
+}
+
+// Zero returns the underlying value of p if p is valid, otherwise returns
+// the zero netip.Prefix.
+func (p Prefix) Zero() netip.Prefix {
+	if p.Valid {
+		return p.Prefix
+	}
+	return netip.Prefix{}
+}
+
+// From sets the underlying value of p to v. p becomes valid.
+func (p *Prefix) From(v netip.Prefix) {
+	p.Prefix = v
+	p.Valid = true
+}
+
+// FromPtr invalidates p if v is nil, otherwise it sets the underlying value
+// of p to the value pointed to by v, and p becomes valid.
+func (p *Prefix) FromPtr(v *netip.Prefix) {
+	p.Valid = v != nil
+	if v != nil {
+		p.Prefix = *v
+	}
+}
+
+// FromZero invalidates p if v is the zero netip.Prefix, otherwise it sets
+// the underlying value of p to v, and p becomes valid.
+func (p *Prefix) FromZero(v netip.Prefix) {
+	p.Prefix = v
+	p.Valid = v.IsValid()
+}
+
+// IsValid returns true if p is valid.
+func (p Prefix) IsValid() bool {
+	return p.Valid
+}
+
+// Invalidate makes p invalid.
+func (p *Prefix) Invalidate() {
+	p.Valid = false
+}
+
+// Interface returns the underlying value of p as netip.Prefix if p is valid,
+// otherwise nil.
+func (p Prefix) Interface() interface{} {
+	if p.Valid {
+		return p.Prefix
+	}
+	return nil
+}
+
+// SetInterface invalidates p if v is nil, otherwise if v's type is
+// netip.Prefix, it sets the underlying value of p to v, and p becomes valid.
+// If v's type is any other type, p becomes invalid, and a TypeError is
+// returned.
+func (p *Prefix) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case netip.Prefix:
+		p.From(value)
+		return nil
+	case nil:
+		p.Valid = false
+		return nil
+	default:
+		p.Valid = false
+		return makeTypeError("set", value, "netip.Prefix", "nil")
+	}
+}
+
+// String returns a string representation of p. If p is valid,
+// it formats the underlying value of p like netip.Prefix.String does,
+// otherwise it returns InvalidNullableString.
+func (p Prefix) String() string {
+	if p.Valid {
+		return p.Prefix.String()
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals p to a byte string representation. If p is valid,
+// it formats the underlying value of p like netip.Prefix.String does,
+// otherwise it returns nil. err is always nil.
+func (p Prefix) MarshalText() (data []byte, err error) {
+	if p.Valid {
+		return []byte(p.Prefix.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of p to a JSON string if p is
+// valid, otherwise it returns the JSON null value. err is always nil.
+func (p Prefix) MarshalJSON() (data []byte, err error) {
+	if p.Valid {
+		return []byte(`"` + p.Prefix.String() + `"`), nil
+	}
+	return jNull, nil
+}
+
+// Value returns the string representation of the underlying value of p if p
+// is valid, otherwise nil. err is always nil.
+func (p Prefix) Value() (v driver.Value, err error) {
+	if p.Valid {
+		return p.Prefix.String(), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates p if str is the empty string, otherwise it parses str into
+// the underlying value of p, and p becomes valid. str is an IP prefix in
+// CIDR notation, as accepted by netip.ParsePrefix, whose address is not
+// masked. A bare address is accepted as well, and it becomes a single-host
+// prefix, since PostgreSQL formats inet values this way. If str cannot be
+// parsed, p becomes invalid and a ParseError is returned.
+func (p *Prefix) Set(str string) error {
+	if str == "" {
+		p.Valid = false
+		return nil
+	}
+
+	p.Prefix, p.Valid = parsePrefix(str)
+	if !p.Valid {
+		return makeParseError("parse", str, *p)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to p.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (p *Prefix) UnmarshalText(text []byte) error {
+	if p.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *p)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to p.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, p becomes invalid. If the encoded JSON data
+// represent a JSON string which Set accepts, p becomes valid, and the
+// underlying value of p is set accordingly, otherwise a ParseError is
+// returned. Other JSON types produce a TypeError. Malformed JSON produces an
+// UnmarshalError.
+func (p *Prefix) UnmarshalJSON(data []byte) error {
+	var obj interface{}
+	if json.Unmarshal(data, &obj) != nil {
+		p.Valid = false
+		return makeUnmarshalError("json", data, *p)
+	}
+	switch value := obj.(type) {
+	case string:
+		if p.Set(value) != nil || value == "" {
+			p.Valid = false
+			return makeParseError("parse", value, *p)
+		}
+		return nil
+	case nil:
+		p.Valid = false
+		return nil
+	default:
+		p.Valid = false
+		return makeTypeError("json", value, "string", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is parsed like Set does, except that the empty string produces
+// a ParseError. If obj is nil, p becomes invalid. If obj's type is any other
+// type, p becomes invalid, and a TypeError is returned.
+func (p *Prefix) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case string:
+		return p.scanString(value)
+	case []byte:
+		return p.scanString(string(value))
+	case nil:
+		p.Valid = false
+		return nil
+	default:
+		p.Valid = false
+		return makeTypeError("sql", value, "string", "[]byte", "nil")
+	}
+}
+
+// scanString parses a prefix returned by a database driver as a string.
+// Unlike Set, the empty string is not accepted.
+func (p *Prefix) scanString(str string) error {
+	p.Prefix, p.Valid = parsePrefix(str)
+	if !p.Valid {
+		return makeParseError("sql", str, *p)
+	}
+	return nil
+}
+
+// parsePrefix parses str as an IP prefix, or as an IP address, which
+// becomes a single-host prefix.
+func parsePrefix(str string) (netip.Prefix, bool) {
+	if v, err := netip.ParsePrefix(str); err == nil {
+		return v, true
+	}
+	a, err := netip.ParseAddr(str)
+	if err != nil || a.Zone() != "" {
+		return netip.Prefix{}, false
+	}
+	return netip.PrefixFrom(a, a.BitLen()), true
+}
@@ -0,0 +1,239 @@
+package null_test
+
+import (
+	"net/netip"
+	"null"
+	"reflect"
+	"testing"
+)
+
+func TestPrefixFromZero(t *testing.T) {
+	cases := []struct {
+		value netip.Prefix
+		valid bool
+	}{
+		{netip.MustParsePrefix("192.0.2.0/24"), true},
+		{netip.Prefix{}, false},
+	}
+
+	for n, c := range cases {
+		v := null.PrefixFromZero(c.value)
+		if c.valid != v.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, v.Valid,
+			)
+		}
+	}
+}
+
+func TestPrefix_String(t *testing.T) {
+	cases := []struct {
+		nullable null.Prefix
+		string   string
+	}{
+		{null.PrefixFrom(netip.MustParsePrefix("192.0.2.0/24")), "192.0.2.0/24"},
+		{null.Prefix{}, "<invalid>"},
+		{null.Prefix{Prefix: netip.MustParsePrefix("192.0.2.0/24")}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestPrefix_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		nullable null.Prefix
+		json     []byte
+	}{
+		{null.PrefixFrom(netip.MustParsePrefix("192.0.2.0/24")), []byte(`"192.0.2.0/24"`)},
+		{null.Prefix{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestPrefix_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.Prefix
+		value    interface{}
+	}{
+		{null.PrefixFrom(netip.MustParsePrefix("192.0.2.0/24")), "192.0.2.0/24"},
+		{null.Prefix{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestPrefix_Set(t *testing.T) {
+	var v null.Prefix
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		result  string
+		errType reflect.Type
+	}{
+		{"192.0.2.0/24", "192.0.2.0/24", nilType},
+		{"192.0.2.1/24", "192.0.2.1/24", nilType},
+		{"2001:db8::/32", "2001:db8::/32", nilType},
+		{"192.0.2.1", "192.0.2.1/32", nilType},
+		{"192.0.2.0/33", "<invalid>", parseErrType},
+		{"fe80::1%eth0", "<invalid>", parseErrType},
+		{"", "<invalid>", nilType},
+		{"x", "<invalid>", parseErrType},
+	}
+
+	for n, c := range cases {
+		err := v.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.result != v.String() {
+			t.Fatalf(
+				"%s, case #%d: result mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.result, v.String(),
+			)
+		}
+	}
+}
+
+func TestPrefix_UnmarshalText(t *testing.T) {
+	var v null.Prefix
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("192.0.2.0/24"), true, nilType},
+		{nil, false, nilType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := v.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != v.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, v.Valid,
+			)
+		}
+	}
+}
+
+func TestPrefix_UnmarshalJSON(t *testing.T) {
+	var v null.Prefix
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`"192.0.2.0/24"`), true, nilType},
+		{[]byte("null"), false, nilType},
+		{[]byte(`""`), false, parseErrType},
+		{[]byte(`"x"`), false, parseErrType},
+		{[]byte("1"), false, typeErrType},
+		{nil, false, unmarshalErrType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := v.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != v.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, v.Valid,
+			)
+		}
+	}
+}
+
+func TestPrefix_Scan(t *testing.T) {
+	var v null.Prefix
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		result  string
+		errType reflect.Type
+	}{
+		{"192.0.2.0/24", "192.0.2.0/24", nilType},
+		{[]byte("192.0.2.0/24"), "192.0.2.0/24", nilType},
+		{"192.0.2.1", "192.0.2.1/32", nilType},
+		{"192.168.1.5/24", "192.168.1.5/24", nilType},
+		{nil, "<invalid>", nilType},
+		{"", "<invalid>", parseErrType},
+		{[]byte("x"), "<invalid>", parseErrType},
+		{int64(1), "<invalid>", typeErrType},
+	}
+
+	for n, c := range cases {
+		err := v.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.result != v.String() {
+			t.Fatalf(
+				"%s, case #%d: result mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.result, v.String(),
+			)
+		}
+	}
+}
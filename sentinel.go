@@ -0,0 +1,248 @@
+package null
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Sentinel implements a nullable T for legacy schemas which represent
+// undefined values with a sentinel value, such as -1, 0001-01-01 or N/A,
+// rather than with SQL NULL. The sentinel is configured in the Sentinel
+// field, for instance:
+//
+//	var age = null.Sentinel[int]{Sentinel: -1}
+//
+// Scan, as well as Set, UnmarshalText and UnmarshalJSON, make s invalid
+// when they read the sentinel, and Value writes the sentinel back when s is
+// invalid, whereas JSON and text representations use null and nil as usual.
+// Values are compared with the sentinel with their Equal method if T has
+// one, such as time.Time, otherwise with the == operator. Conversions are
+// performed like Of does.
+type Sentinel[T comparable] struct {
+	// Val holds the underlying T value.
+	Val T
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+
+	// Sentinel holds the value which represents an undefined value in the
+	// database.
+	Sentinel T
+}
+
+// SentinelFrom creates a valid Sentinel from v, whose sentinel is sentinel.
+func SentinelFrom[T comparable](v, sentinel T) Sentinel[T] {
+	return SentinelFromPtr(&v, sentinel)
+}
+
+// SentinelFromPtr creates a Sentinel from pointer p, whose sentinel is
+// sentinel. If p is nil, the returned Sentinel is invalid.
+func SentinelFromPtr[T comparable](p *T, sentinel T) Sentinel[T] {
+	s := Sentinel[T]{Sentinel: sentinel}
+	s.FromPtr(p)
+	return s
+}
+
+// SentinelFromZero creates a Sentinel from v, whose sentinel is sentinel.
+// If v is equal to sentinel, the returned Sentinel is invalid.
+func SentinelFromZero[T comparable](v, sentinel T) Sentinel[T] {
+	s := Sentinel[T]{Sentinel: sentinel}
+	s.FromZero(v)
+	return s
+}
+
+// Ptr returns a pointer to the underlying value of s if s is valid,
+// otherwise returns nil.
+func (s Sentinel[T]) Ptr() *T {
+	if s.Valid {
+		return &s.Val
+	}
+	return nil
+}
+
+// Zero returns the underlying value of s if s is valid, otherwise
+// returns the zero value of T.
+func (s Sentinel[T]) Zero() T {
+	if s.Valid {
+		return s.Val
+	}
+	var zero T
+	return zero
+}
+
+// From sets the underlying value of s to v. s becomes valid.
+func (s *Sentinel[T]) From(v T) {
+	s.Valid = true
+	s.Val = v
+}
+
+// FromPtr invalidates s if p is nil, otherwise it sets the underlying value
+// of s to the value pointed to by p, and s becomes valid.
+func (s *Sentinel[T]) FromPtr(p *T) {
+	s.Valid = p != nil
+	if p != nil {
+		s.Val = *p
+	}
+}
+
+// FromZero invalidates s if v is equal to the sentinel of s, otherwise it
+// sets the underlying value of s to v, and s becomes valid.
+func (s *Sentinel[T]) FromZero(v T) {
+	s.Valid = !s.isSentinel(v)
+	s.Val = v
+}
+
+// IsValid returns true if s is valid.
+func (s Sentinel[T]) IsValid() bool {
+	return s.Valid
+}
+
+// Invalidate makes s invalid.
+func (s *Sentinel[T]) Invalidate() {
+	s.Valid = false
+}
+
+// Interface returns the underlying value of s as T if s is valid,
+// otherwise nil.
+func (s Sentinel[T]) Interface() interface{} {
+	if s.Valid {
+		return s.Val
+	}
+	return nil
+}
+
+// SetInterface invalidates s if v is nil, otherwise if v's type is T,
+// it sets the underlying value of s to v, and s becomes valid.
+// If v's type is any other type, s becomes invalid, and a TypeError is
+// returned.
+func (s *Sentinel[T]) SetInterface(v interface{}) error {
+	if v == nil {
+		s.Valid = false
+		return nil
+	}
+	value, ok := v.(T)
+	if !ok {
+		s.Valid = false
+		return makeTypeError("set", v, typeName(s.Val), "nil")
+	}
+	s.From(value)
+	return nil
+}
+
+// String returns a string representation of s. If s is valid,
+// it returns the underlying value of s formatted with fmt.Sprint,
+// otherwise it returns InvalidNullableString.
+func (s Sentinel[T]) String() string {
+	if s.Valid {
+		return fmt.Sprint(s.Val)
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals s to a byte string representation like
+// Of.MarshalText does. If s is invalid, it returns nil.
+func (s Sentinel[T]) MarshalText() (data []byte, err error) {
+	data, err = s.of().MarshalText()
+	if err != nil {
+		return nil, makeMarshalError("text", s)
+	}
+	return data, nil
+}
+
+// MarshalJSON encodes the underlying value of s with json.Marshal if s is
+// valid, otherwise it returns the JSON null value, rather than the sentinel.
+// If the underlying value of s cannot be marshaled, a MarshalError is
+// returned.
+func (s Sentinel[T]) MarshalJSON() (data []byte, err error) {
+	data, err = s.of().MarshalJSON()
+	if err != nil {
+		return nil, makeMarshalError("json", s)
+	}
+	return data, nil
+}
+
+// Value returns the underlying value of s if s is valid, otherwise the
+// sentinel of s, converted to a driver.Value like Of.Value does. If the
+// conversion is not possible, a MarshalError is returned.
+func (s Sentinel[T]) Value() (v driver.Value, err error) {
+	o := Of[T]{Val: s.Val, Valid: true}
+	if !s.Valid {
+		o.Val = s.Sentinel
+	}
+	v, err = o.Value()
+	if err != nil {
+		return nil, makeMarshalError("sql", s)
+	}
+	return v, nil
+}
+
+// Set invalidates s if str is the empty string, otherwise it parses str into
+// the underlying value of s like Of.Set does, and s becomes valid, unless the
+// parsed value is equal to the sentinel of s. If str cannot be parsed into a
+// T, s becomes invalid and a ParseError is returned.
+func (s *Sentinel[T]) Set(str string) error {
+	var o Of[T]
+	err := o.Set(str)
+	s.assign(o)
+	if err != nil {
+		return makeParseError("parse", str, *s)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to s.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (s *Sentinel[T]) UnmarshalText(text []byte) error {
+	if s.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *s)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to s like
+// Of.UnmarshalJSON does. If the encoded JSON data represent the JSON null
+// value or the sentinel of s, or an error is produced, s becomes invalid.
+// Malformed JSON, or JSON that cannot be unmarshaled into a T, produces an
+// UnmarshalError.
+func (s *Sentinel[T]) UnmarshalJSON(data []byte) error {
+	var o Of[T]
+	err := o.UnmarshalJSON(data)
+	s.assign(o)
+	if err != nil {
+		return makeUnmarshalError("json", data, *s)
+	}
+	return nil
+}
+
+// Scan assigns a value from a database driver like Of.Scan does. If obj is
+// nil, or it is converted to a value equal to the sentinel of s, s becomes
+// invalid. Errors produced by the conversion are passed through.
+func (s *Sentinel[T]) Scan(obj interface{}) error {
+	var o Of[T]
+	err := o.Scan(obj)
+	s.assign(o)
+	return err
+}
+
+// of returns s as an Of[T] with the same underlying value and validity.
+func (s Sentinel[T]) of() Of[T] {
+	return Of[T]{Val: s.Val, Valid: s.Valid}
+}
+
+// assign sets the underlying value and validity of s to those of o, except
+// that s becomes invalid if the underlying value of o is equal to the
+// sentinel of s.
+func (s *Sentinel[T]) assign(o Of[T]) {
+	s.Val = o.Val
+	s.Valid = o.Valid && !s.isSentinel(o.Val)
+}
+
+// isSentinel reports whether v is equal to the sentinel of s.
+func (s Sentinel[T]) isSentinel(v T) bool {
+	if e, ok := interface{}(v).(interface{ Equal(T) bool }); ok {
+		return e.Equal(s.Sentinel)
+	}
+	return v == s.Sentinel
+}
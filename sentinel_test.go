@@ -0,0 +1,259 @@
+package null_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"null"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// legacyDate is the sentinel of legacy DATE columns.
+var legacyDate = time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func TestSentinelFromZero(t *testing.T) {
+	cases := []struct {
+		nullable interface{ IsValid() bool }
+		valid    bool
+	}{
+		{null.SentinelFromZero(1, -1), true},
+		{null.SentinelFromZero(0, -1), true},
+		{null.SentinelFromZero(-1, -1), false},
+		{null.SentinelFromZero("N/A", "N/A"), false},
+		{null.SentinelFromZero(legacyDate.In(time.Local), legacyDate), false},
+	}
+
+	for n, c := range cases {
+		if c.valid != c.nullable.IsValid() {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, c.nullable.IsValid(),
+			)
+		}
+	}
+}
+
+func TestSentinel_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		nullable null.Sentinel[int]
+		json     []byte
+	}{
+		{null.SentinelFrom(42, -1), []byte("42")},
+		{null.Sentinel[int]{Sentinel: -1}, []byte("null")},
+		{null.Sentinel[int]{Val: -1, Sentinel: -1}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+
+	b, err := json.Marshal(struct {
+		Name null.Sentinel[string] `json:"name"`
+	}{null.Sentinel[string]{Sentinel: "N/A"}})
+	if err != nil || string(b) != `{"name":null}` {
+		t.Fatalf("%s: embedding mismatch (got '%s', %v)", t.Name(), b, err)
+	}
+}
+
+func TestSentinel_Value(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable driver.Valuer
+		value    interface{}
+		errType  reflect.Type
+	}{
+		{null.SentinelFrom(42, -1), int64(42), nilType},
+		{null.Sentinel[int]{Sentinel: -1}, int64(-1), nilType},
+		{null.Sentinel[string]{Sentinel: "N/A"}, "N/A", nilType},
+		{
+			null.Sentinel[time.Time]{Sentinel: legacyDate},
+			legacyDate,
+			nilType,
+		},
+		{
+			null.Sentinel[chan int]{Val: make(chan int), Valid: true},
+			nil,
+			marshalErrType,
+		},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.value, v) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestSentinel_Set(t *testing.T) {
+	s := null.Sentinel[int]{Sentinel: -1}
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		valid   bool
+		errType reflect.Type
+	}{
+		{"42", true, nilType},
+		{"0", true, nilType},
+		{"-1", false, nilType},
+		{"", false, nilType},
+		{"x", false, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := s.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != s.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, s.Valid,
+			)
+		}
+		if s.Sentinel != -1 {
+			t.Fatalf("%s, case #%d: sentinel was modified", t.Name(), n+1)
+		}
+	}
+}
+
+func TestSentinel_UnmarshalText(t *testing.T) {
+	s := null.Sentinel[string]{Sentinel: "N/A"}
+	nilType := reflect.TypeOf(nil)
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("Ada"), true, nilType},
+		{[]byte("N/A"), false, nilType},
+		{nil, false, nilType},
+	}
+
+	for n, c := range cases {
+		err := s.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != s.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, s.Valid,
+			)
+		}
+	}
+}
+
+func TestSentinel_UnmarshalJSON(t *testing.T) {
+	s := null.Sentinel[int]{Sentinel: -1}
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("42"), true, nilType},
+		{[]byte("null"), false, nilType},
+		{[]byte("-1"), false, nilType},
+		{[]byte(`"42"`), false, unmarshalErrType},
+		{[]byte("{"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := s.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != s.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, s.Valid,
+			)
+		}
+	}
+}
+
+func TestSentinel_Scan(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		nullable interface {
+			Scan(interface{}) error
+			IsValid() bool
+		}
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{&null.Sentinel[int]{Sentinel: -1}, int64(42), true, nilType},
+		{&null.Sentinel[int]{Sentinel: -1}, int64(-1), false, nilType},
+		{&null.Sentinel[int]{Sentinel: -1}, nil, false, nilType},
+		{&null.Sentinel[int]{Sentinel: -1}, "x", false, typeErrType},
+		{&null.Sentinel[string]{Sentinel: "N/A"}, []byte("N/A"), false, nilType},
+		{&null.Sentinel[string]{Sentinel: "N/A"}, "Ada", true, nilType},
+		{
+			&null.Sentinel[time.Time]{Sentinel: legacyDate},
+			legacyDate.In(time.FixedZone("", 0)),
+			false,
+			nilType,
+		},
+		{
+			&null.Sentinel[time.Time]{Sentinel: legacyDate},
+			legacyDate.AddDate(0, 0, 1),
+			true,
+			nilType,
+		},
+	}
+
+	for n, c := range cases {
+		err := c.nullable.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != c.nullable.IsValid() {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, c.nullable.IsValid(),
+			)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Slice implements a nullable []T, which tells apart an invalid slice from a
+// valid but empty one: the former is encoded as the JSON null value, the
+// latter as an empty JSON array. It is stored in SQL as a JSON array, so that
+// it can be used with JSON columns such as PostgreSQL json and jsonb.
+type Slice[T any] struct {
+	// Slice holds the underlying []T value.
+	Slice []T
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// SliceFrom creates a valid Slice from v.
+func SliceFrom[T any](v []T) Slice[T] {
+	return SliceFromPtr(&v)
+}
+
+// SliceFromPtr creates a Slice from pointer p. If p is nil,
+// the returned Slice is invalid.
+func SliceFromPtr[T any](p *[]T) Slice[T] {
+	if p != nil {
+		return Slice[T]{
+			Slice: *p,
+			Valid: true,
+		}
+	}
+	return Slice[T]{}
+}
+
+// SliceFromZero creates a Slice from v. If v is nil, the returned Slice is
+// invalid. An empty but non-nil v produces a valid Slice.
+func SliceFromZero[T any](v []T) Slice[T] {
+	return Slice[T]{
+		Slice: v,
+		Valid: v != nil,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of s if s is valid,
+// otherwise returns nil.
+func (s Slice[T]) Ptr() *[]T {
+	if s.Valid {
+		return &s.Slice
+	}
+	return nil
+}
+
+// Zero returns the underlying value of s if s is valid, otherwise
+// returns nil.
+func (s Slice[T]) Zero() []T {
+	if s.Valid {
+		return s.Slice
+	}
+	return nil
+}
+
+// From sets the underlying value of s to v. s becomes valid.
+func (s *Slice[T]) From(v []T) {
+	s.Valid = true
+	s.Slice = v
+}
+
+// FromPtr invalidates s if p is nil, otherwise it sets the underlying value
+// of s to the value pointed to by p, and s becomes valid.
+func (s *Slice[T]) FromPtr(p *[]T) {
+	s.Valid = p != nil
+	if p != nil {
+		s.Slice = *p
+	}
+}
+
+// FromZero invalidates s if v is nil, otherwise it sets the underlying value
+// of s to v, and s becomes valid.
+func (s *Slice[T]) FromZero(v []T) {
+	s.Valid = v != nil
+	s.Slice = v
+}
+
+// IsValid returns true if s is valid.
+func (s Slice[T]) IsValid() bool {
+	return s.Valid
+}
+
+// Invalidate makes s invalid.
+func (s *Slice[T]) Invalidate() {
+	s.Valid = false
+}
+
+// Interface returns the underlying value of s as []T if s is valid,
+// otherwise nil.
+func (s Slice[T]) Interface() interface{} {
+	if s.Valid {
+		return s.Slice
+	}
+	return nil
+}
+
+// SetInterface invalidates s if v is nil, otherwise if v's type is []T,
+// it sets the underlying value of s to v, and s becomes valid.
+// If v's type is any other type, s becomes invalid, and a TypeError is
+// returned.
+func (s *Slice[T]) SetInterface(v interface{}) error {
+	if v == nil {
+		s.Valid = false
+		return nil
+	}
+	value, ok := v.([]T)
+	if !ok {
+		s.Valid = false
+		return makeTypeError("set", v, typeName(s.Slice), "nil")
+	}
+	s.Slice = value
+	s.Valid = true
+	return nil
+}
+
+// String returns a string representation of s. If s is valid,
+// it returns the underlying value of s encoded as a JSON array, or
+// formatted with fmt.Sprint if it cannot be encoded, otherwise it returns
+// InvalidNullableString.
+func (s Slice[T]) String() string {
+	if !s.Valid {
+		return InvalidNullableString
+	}
+	bytes, err := s.marshal("text")
+	if err != nil {
+		return fmt.Sprint(s.Slice)
+	}
+	return string(bytes)
+}
+
+// MarshalText marshals s to a byte string representation. If s is valid,
+// it encodes the underlying value of s as a JSON array, otherwise it returns
+// nil. If the elements of s cannot be encoded, a MarshalError is returned.
+func (s Slice[T]) MarshalText() (data []byte, err error) {
+	if s.Valid {
+		return s.marshal("text")
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of s to a JSON array if s is
+// valid, even if the underlying value of s is nil, otherwise it returns the
+// JSON null value. If the elements of s cannot be encoded, a MarshalError is
+// returned.
+func (s Slice[T]) MarshalJSON() (data []byte, err error) {
+	if s.Valid {
+		return s.marshal("json")
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of s encoded as a JSON array in a
+// string if s is valid, otherwise nil. If the elements of s cannot be
+// encoded, a MarshalError is returned.
+func (s Slice[T]) Value() (v driver.Value, err error) {
+	if s.Valid {
+		bytes, err := s.marshal("sql")
+		if err != nil {
+			return nil, err
+		}
+		return string(bytes), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates s if str is the empty string, otherwise it decodes str,
+// which holds a JSON array, into the underlying value of s, and s becomes
+// valid, unless str holds the JSON null value. If str cannot be decoded into
+// a []T, s becomes invalid and a ParseError is returned.
+func (s *Slice[T]) Set(str string) error {
+	if str == "" {
+		s.Valid = false
+		return nil
+	}
+
+	if s.unmarshal("parse", []byte(str)) != nil {
+		return makeParseError("parse", str, s.Slice)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to s.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be decoded.
+func (s *Slice[T]) UnmarshalText(text []byte) error {
+	if s.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, s.Slice)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to s.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, s becomes invalid. If the encoded JSON data
+// represent a JSON array, s becomes valid, and the underlying value of s is
+// set to a new slice holding the decoded elements, which is empty but not
+// nil if the array is empty. Malformed JSON, or JSON that cannot be decoded
+// into a []T, produces an UnmarshalError.
+func (s *Slice[T]) UnmarshalJSON(data []byte) error {
+	return s.unmarshal("json", data)
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is decoded like UnmarshalJSON does, except that the prefix of
+// the returned UnmarshalError is "sql". If obj is nil, s becomes invalid.
+// If obj's type is any other type, s becomes invalid, and a TypeError is
+// returned.
+func (s *Slice[T]) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case []byte:
+		return s.unmarshal("sql", value)
+	case string:
+		return s.unmarshal("sql", []byte(value))
+	case nil:
+		s.Valid = false
+		return nil
+	default:
+		s.Valid = false
+		return makeTypeError("sql", value, "[]byte", "string", "nil")
+	}
+}
+
+// marshal encodes the underlying value of s to a JSON array, and returns a
+// MarshalError with the given prefix if it cannot be encoded. A nil slice is
+// encoded as an empty JSON array.
+func (s Slice[T]) marshal(prefix string) ([]byte, error) {
+	v := s.Slice
+	if v == nil {
+		v = []T{}
+	}
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return nil, makeMarshalError(prefix, s)
+	}
+	return bytes, nil
+}
+
+// unmarshal decodes data into a new slice, which becomes the underlying
+// value of s. s becomes invalid if data holds the JSON null value, and an
+// UnmarshalError with the given prefix is returned if data cannot be
+// decoded.
+func (s *Slice[T]) unmarshal(prefix string, data []byte) error {
+	var v []T
+	if json.Unmarshal(data, &v) != nil {
+		s.Valid = false
+		return makeUnmarshalError(prefix, data, v)
+	}
+	s.Slice = v
+	s.Valid = !bytes.Equal(bytes.TrimSpace(data), jNull)
+	return nil
+}
@@ -0,0 +1,327 @@
+package null_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"null"
+	"reflect"
+	"testing"
+)
+
+func TestSliceFromZero(t *testing.T) {
+	cases := []struct {
+		value []int
+		valid bool
+	}{
+		{[]int{1}, true},
+		{[]int{}, true},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		s := null.SliceFromZero(c.value)
+		if c.valid != s.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, s.Valid,
+			)
+		}
+	}
+}
+
+func TestSlice_String(t *testing.T) {
+	cases := []struct {
+		nullable null.Slice[string]
+		string   string
+	}{
+		{null.SliceFrom([]string{"a", "b"}), `["a","b"]`},
+		{null.SliceFrom([]string{}), "[]"},
+		{null.SliceFrom[string](nil), "[]"},
+		{null.Slice[string]{}, "<invalid>"},
+		{null.Slice[string]{Slice: []string{"a"}}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestSlice_MarshalText(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable interface{ MarshalText() ([]byte, error) }
+		bytes    []byte
+		errType  reflect.Type
+	}{
+		{null.SliceFrom([]int{1, 2}), []byte("[1,2]"), nilType},
+		{null.Slice[int]{}, nil, nilType},
+		{null.SliceFrom([]chan int{nil}), nil, marshalErrType},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalText()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.bytes, b) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(b),
+			)
+		}
+	}
+}
+
+func TestSlice_MarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable interface{ MarshalJSON() ([]byte, error) }
+		json     []byte
+		errType  reflect.Type
+	}{
+		{null.SliceFrom([]string{"a"}), []byte(`["a"]`), nilType},
+		{null.SliceFrom([]string{}), []byte("[]"), nilType},
+		{null.SliceFrom[string](nil), []byte("[]"), nilType},
+		{null.Slice[string]{}, []byte("null"), nilType},
+		{null.SliceFrom([]chan int{nil}), nil, marshalErrType},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+
+	b, err := json.Marshal(struct {
+		Tags null.Slice[string] `json:"tags"`
+		More null.Slice[string] `json:"more"`
+	}{null.SliceFrom([]string{}), null.Slice[string]{}})
+	if err != nil || string(b) != `{"tags":[],"more":null}` {
+		t.Fatalf("%s: embedding mismatch (got '%s', %v)", t.Name(), b, err)
+	}
+}
+
+func TestSlice_Value(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable interface {
+			Value() (driver.Value, error)
+		}
+		value   interface{}
+		errType reflect.Type
+	}{
+		{null.SliceFrom([]int{1, 2}), "[1,2]", nilType},
+		{null.SliceFrom([]int{}), "[]", nilType},
+		{null.Slice[int]{}, nil, nilType},
+		{null.SliceFrom([]chan int{nil}), nil, marshalErrType},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestSlice_Set(t *testing.T) {
+	var s null.Slice[int]
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		valid   bool
+		value   []int
+		errType reflect.Type
+	}{
+		{"[1,2]", true, []int{1, 2}, nilType},
+		{"[]", true, []int{}, nilType},
+		{"null", false, nil, nilType},
+		{"", false, nil, nilType},
+		{"{}", false, nil, parseErrType},
+		{`["a"]`, false, nil, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := s.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != s.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, s.Valid,
+			)
+		}
+		if s.Valid && !reflect.DeepEqual(c.value, s.Slice) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %#v, got %#v)",
+				t.Name(), n+1, c.value, s.Slice,
+			)
+		}
+	}
+}
+
+func TestSlice_UnmarshalText(t *testing.T) {
+	var s null.Slice[int]
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("[1]"), true, nilType},
+		{nil, false, nilType},
+		{[]byte("1"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := s.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != s.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, s.Valid,
+			)
+		}
+	}
+}
+
+func TestSlice_UnmarshalJSON(t *testing.T) {
+	var s null.Slice[string]
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		value   []string
+		errType reflect.Type
+	}{
+		{[]byte(`["a","b"]`), true, []string{"a", "b"}, nilType},
+		{[]byte("[]"), true, []string{}, nilType},
+		{[]byte("null"), false, nil, nilType},
+		{[]byte(`"a"`), false, nil, unmarshalErrType},
+		{nil, false, nil, unmarshalErrType},
+		{[]byte("["), false, nil, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := s.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != s.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, s.Valid,
+			)
+		}
+		if s.Valid && !reflect.DeepEqual(c.value, s.Slice) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %#v, got %#v)",
+				t.Name(), n+1, c.value, s.Slice,
+			)
+		}
+	}
+
+	var v struct {
+		Tags null.Slice[string] `json:"tags"`
+		More null.Slice[string] `json:"more"`
+	}
+	err := json.Unmarshal([]byte(`{"tags":[],"more":null}`), &v)
+	if err != nil || !v.Tags.Valid || v.Tags.Slice == nil || v.More.Valid {
+		t.Fatalf("%s: embedding mismatch (got %v, %v)", t.Name(), v, err)
+	}
+}
+
+func TestSlice_Scan(t *testing.T) {
+	var s null.Slice[int]
+	nilType := reflect.TypeOf(nil)
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		value   []int
+		errType reflect.Type
+	}{
+		{[]byte("[1,2]"), true, []int{1, 2}, nilType},
+		{"[]", true, []int{}, nilType},
+		{"null", false, nil, nilType},
+		{nil, false, nil, nilType},
+		{"", false, nil, unmarshalErrType},
+		{"{1}", false, nil, unmarshalErrType},
+		{int64(1), false, nil, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := s.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != s.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, s.Valid,
+			)
+		}
+		if s.Valid && !reflect.DeepEqual(c.value, s.Slice) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %#v, got %#v)",
+				t.Name(), n+1, c.value, s.Slice,
+			)
+		}
+	}
+}
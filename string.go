@@ -82,6 +82,44 @@ func (s *String) FromZero(v string) {
 	s.Str = v
 }
 
+// IsValid returns true if s is valid.
+func (s String) IsValid() bool {
+	return s.Valid
+}
+
+// Invalidate makes s invalid.
+func (s *String) Invalidate() {
+	s.Valid = false
+}
+
+// Interface returns the underlying value of s as string if s is valid,
+// otherwise nil.
+func (s String) Interface() interface{} {
+	if s.Valid {
+		return s.Str
+	}
+	return nil
+}
+
+// SetInterface invalidates s if v is nil, otherwise if v's type is string,
+// it sets the underlying value of s to v, and s becomes valid.
+// If v's type is any other type, s becomes invalid, and a TypeError is
+// returned.
+func (s *String) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case string:
+		s.Str = value
+		s.Valid = true
+		return nil
+	case nil:
+		s.Valid = false
+		return nil
+	default:
+		s.Valid = false
+		return makeTypeError("set", value, "string", "nil")
+	}
+}
+
 // String returns the underlying value of s if s is valid,
 // and InvalidNullableString if not valid.
 func (s String) String() string {
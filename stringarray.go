@@ -0,0 +1,251 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// StringArray implements a nullable []String, meant to be used with PostgreSQL
+// text[] columns. Elements are String values, so that NULL elements are
+// represented by invalid String values. StringArray is represented in text and
+// SQL by a PostgreSQL array literal, such as {a,NULL,"b c"}, and in JSON by an
+// array holding null for NULL elements.
+type StringArray struct {
+	// Array holds the underlying []String value.
+	Array []String
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// StringArrayFrom creates a valid StringArray from v.
+func StringArrayFrom(v []String) StringArray {
+	return StringArrayFromPtr(&v)
+}
+
+// StringArrayFromPtr creates a StringArray from pointer p. If p is nil,
+// the returned StringArray is invalid.
+func StringArrayFromPtr(p *[]String) StringArray {
+	if p != nil {
+		return StringArray{
+			Array: *p,
+			Valid: true,
+		}
+	}
+	return StringArray{}
+}
+
+// StringArrayFromZero creates a StringArray from v. If v is nil, the returned
+// StringArray is invalid. An empty but non-nil v produces a valid StringArray.
+func StringArrayFromZero(v []String) StringArray {
+	return StringArray{
+		Array: v,
+		Valid: v != nil,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of a if a is valid,
+// otherwise returns nil.
+func (a StringArray) Ptr() *[]String {
+	if a.Valid {
+		return &a.Array
+	}
+	return nil
+}
+
+// Zero returns the underlying value of a if a is valid, otherwise returns
+// nil.
+func (a StringArray) Zero() []String {
+	if a.Valid {
+		return a.Array
+	}
+	return nil
+}
+
+// From sets the underlying value of a to v. a becomes valid.
+func (a *StringArray) From(v []String) {
+	a.Array = v
+	a.Valid = true
+}
+
+// FromPtr invalidates a if p is nil, otherwise it sets the underlying value
+// of a to the value pointed to by p, and a becomes valid.
+func (a *StringArray) FromPtr(p *[]String) {
+	a.Valid = p != nil
+	if p != nil {
+		a.Array = *p
+	}
+}
+
+// FromZero invalidates a if v is nil, otherwise it sets the underlying value
+// of a to v, and a becomes valid.
+func (a *StringArray) FromZero(v []String) {
+	a.Array = v
+	a.Valid = v != nil
+}
+
+// IsValid returns true if a is valid.
+func (a StringArray) IsValid() bool {
+	return a.Valid
+}
+
+// Invalidate makes a invalid.
+func (a *StringArray) Invalidate() {
+	a.Valid = false
+}
+
+// Interface returns the underlying value of a as []String if a is valid,
+// otherwise nil.
+func (a StringArray) Interface() interface{} {
+	if a.Valid {
+		return a.Array
+	}
+	return nil
+}
+
+// SetInterface invalidates a if v is nil, otherwise if v's type is
+// []String, it sets the underlying value of a to v, and a becomes valid.
+// If v's type is any other type, a becomes invalid, and a TypeError is
+// returned.
+func (a *StringArray) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case []String:
+		a.From(value)
+		return nil
+	case nil:
+		a.Valid = false
+		return nil
+	default:
+		a.Valid = false
+		return makeTypeError("set", value, "[]String", "nil")
+	}
+}
+
+// String returns a string representation of a. If a is valid,
+// it returns the PostgreSQL array literal holding the elements of a,
+// otherwise it returns InvalidNullableString.
+func (a StringArray) String() string {
+	if a.Valid {
+		return a.literal()
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals a to a byte string representation. If a is valid,
+// it returns the PostgreSQL array literal holding the elements of a,
+// otherwise it returns nil. err is always nil.
+func (a StringArray) MarshalText() (data []byte, err error) {
+	if a.Valid {
+		return []byte(a.literal()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of a to a JSON array if a is
+// valid, even if the underlying value of a is nil, otherwise it returns the
+// JSON null value. Invalid elements are encoded as the JSON null value.
+// err is always nil.
+func (a StringArray) MarshalJSON() (data []byte, err error) {
+	if !a.Valid {
+		return jNull, nil
+	}
+	v := a.Array
+	if v == nil {
+		v = []String{}
+	}
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return nil, makeMarshalError("json", a)
+	}
+	return bytes, nil
+}
+
+// Value returns the PostgreSQL array literal holding the elements of a if
+// a is valid, otherwise nil. err is always nil.
+func (a StringArray) Value() (v driver.Value, err error) {
+	if a.Valid {
+		return a.literal(), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates a if str is the empty string, otherwise it parses str,
+// which holds a one-dimensional PostgreSQL array literal, into the
+// underlying value of a, and a becomes valid. Unquoted NULL elements become
+// invalid elements. If str holds a multi-dimensional array, a becomes
+// invalid and a DimensionError is returned. If str cannot be parsed,
+// a becomes invalid and a ParseError is returned.
+func (a *StringArray) Set(str string) error {
+	if str == "" {
+		a.Valid = false
+		return nil
+	}
+	return a.parse("parse", str)
+}
+
+// UnmarshalText unmarshals from a byte string to a.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError or a DimensionError in case text cannot be parsed.
+func (a *StringArray) UnmarshalText(text []byte) error {
+	if a.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *a)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to a.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, a becomes invalid. If the encoded JSON data
+// represent a JSON array, a becomes valid, and the underlying value of a is
+// set to a new slice holding the elements of the array, which are
+// unmarshaled like String.UnmarshalJSON does. Malformed JSON, other JSON types,
+// or elements which String.UnmarshalJSON rejects produce an UnmarshalError.
+func (a *StringArray) UnmarshalJSON(data []byte) error {
+	var v []String
+	if json.Unmarshal(data, &v) != nil {
+		a.Valid = false
+		return makeUnmarshalError("json", data, *a)
+	}
+	a.Array, a.Valid = v, v != nil
+	return nil
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is parsed like Set does, except that the empty string produces
+// a ParseError. If obj is nil, a becomes invalid. If obj's type is any other
+// type, a becomes invalid, and a TypeError is returned.
+func (a *StringArray) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case string:
+		return a.parse("sql", value)
+	case []byte:
+		return a.parse("sql", string(value))
+	case nil:
+		a.Valid = false
+		return nil
+	default:
+		a.Valid = false
+		return makeTypeError("sql", value, "string", "[]byte", "nil")
+	}
+}
+
+// literal returns the PostgreSQL array literal holding the elements of a.
+func (a StringArray) literal() string {
+	return formatArrayOf(a.Array, func(e String) (string, bool) {
+		return e.Str, e.Valid
+	})
+}
+
+// parse parses str as a one-dimensional PostgreSQL array literal into the
+// underlying value of a. The returned errors use the given prefix.
+func (a *StringArray) parse(prefix, str string) error {
+	v, err := parseArrayOf(prefix, str, *a, func(e arrayElem) (String, bool) {
+		if e.null {
+			return String{}, true
+		}
+		return StringFrom(e.str), true
+	})
+	a.Array, a.Valid = v, err == nil
+	return err
+}
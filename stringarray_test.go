@@ -0,0 +1,339 @@
+package null_test
+
+import (
+	"null"
+	"reflect"
+	"testing"
+)
+
+// strs builds a []null.String, where nil elements are invalid.
+func strs(v ...interface{}) []null.String {
+	a := make([]null.String, len(v))
+	for i, e := range v {
+		if str, ok := e.(string); ok {
+			a[i] = null.StringFrom(str)
+		}
+	}
+	return a
+}
+
+func TestStringArrayFromZero(t *testing.T) {
+	cases := []struct {
+		value []null.String
+		valid bool
+	}{
+		{strs("a"), true},
+		{strs(), true},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		a := null.StringArrayFromZero(c.value)
+		if c.valid != a.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, a.Valid,
+			)
+		}
+	}
+}
+
+func TestStringArray_String(t *testing.T) {
+	cases := []struct {
+		nullable null.StringArray
+		string   string
+	}{
+		{null.StringArrayFrom(strs("a", nil, "b c")), `{a,NULL,"b c"}`},
+		{null.StringArrayFrom(strs("", "NULL", "null")), `{"","NULL","null"}`},
+		{null.StringArrayFrom(strs(`a"b`, `c\d`)), `{"a\"b","c\\d"}`},
+		{null.StringArrayFrom(strs("{x}", "y,z", "\t")), `{"{x}","y,z","	"}`},
+		{null.StringArrayFrom(strs("é", "1.5")), `{é,1.5}`},
+		{null.StringArrayFrom(strs()), "{}"},
+		{null.StringArrayFrom(nil), "{}"},
+		{null.StringArray{}, "<invalid>"},
+		{null.StringArray{Array: strs("a")}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestStringArray_MarshalText(t *testing.T) {
+	cases := []struct {
+		nullable null.StringArray
+		bytes    []byte
+	}{
+		{null.StringArrayFrom(strs("a", nil)), []byte("{a,NULL}")},
+		{null.StringArray{}, nil},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalText()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.bytes, b) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(b),
+			)
+		}
+	}
+}
+
+func TestStringArray_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		nullable null.StringArray
+		json     []byte
+	}{
+		{null.StringArrayFrom(strs("a", nil, "")), []byte(`["a",null,""]`)},
+		{null.StringArrayFrom(strs()), []byte("[]")},
+		{null.StringArrayFrom(nil), []byte("[]")},
+		{null.StringArray{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestStringArray_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.StringArray
+		value    interface{}
+	}{
+		{null.StringArrayFrom(strs("a", nil, "b c")), `{a,NULL,"b c"}`},
+		{null.StringArrayFrom(strs()), "{}"},
+		{null.StringArray{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestStringArray_Set(t *testing.T) {
+	var a null.StringArray
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	dimErrType := reflect.TypeOf(null.DimensionError{})
+
+	cases := []struct {
+		string  string
+		valid   bool
+		value   []null.String
+		errType reflect.Type
+	}{
+		{`{a,NULL,"b c"}`, true, strs("a", nil, "b c"), nilType},
+		{`{"",null,"NULL"}`, true, strs("", nil, "NULL"), nilType},
+		{`{"a\"b","c\\d",e\,f}`, true, strs(`a"b`, `c\d`, "e,f"), nilType},
+		{`{ a b , "c" ,d }`, true, strs("a b", "c", "d"), nilType},
+		{`{NULL\ ,\NULL}`, true, strs("NULL ", "NULL"), nilType},
+		{"{}", true, strs(), nilType},
+		{"{ }", true, strs(), nilType},
+		{"[0:1]={a,b}", true, strs("a", "b"), nilType},
+		{"", false, nil, nilType},
+		{"a", false, nil, parseErrType},
+		{"{a", false, nil, parseErrType},
+		{"{a,}", false, nil, parseErrType},
+		{"{,a}", false, nil, parseErrType},
+		{`{"a}`, false, nil, parseErrType},
+		{`{"a"b}`, false, nil, parseErrType},
+		{`{a"b"}`, false, nil, parseErrType},
+		{`{a\}`, false, nil, parseErrType},
+		{"{a}}", false, nil, parseErrType},
+		{"{{a},{b}}", false, nil, dimErrType},
+		{"{ {{a}}}", false, nil, dimErrType},
+		{"[1:1][1:1]={{a}}", false, nil, dimErrType},
+	}
+
+	for n, c := range cases {
+		err := a.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != a.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, a.Valid,
+			)
+		}
+		if a.Valid && !reflect.DeepEqual(c.value, a.Array) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, a.Array,
+			)
+		}
+	}
+
+	err := a.Set("{{{a}}}")
+	if e, ok := err.(null.DimensionError); !ok || e.Dimensions != 3 {
+		t.Fatalf("%s: wrong error %v", t.Name(), err)
+	}
+}
+
+func TestStringArray_RoundTrip(t *testing.T) {
+	values := [][]null.String{
+		strs("a", nil, "", "NULL", `"`, `\`, "{", "}", ",", " x ", "\n"),
+		strs(),
+	}
+
+	for n, v := range values {
+		var a null.StringArray
+		if err := a.Set(null.StringArrayFrom(v).String()); err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(v, a.Array) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, v, a.Array,
+			)
+		}
+	}
+}
+
+func TestStringArray_UnmarshalText(t *testing.T) {
+	var a null.StringArray
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("{a}"), true, nilType},
+		{nil, false, nilType},
+		{[]byte("a"), false, unmarshalErrType},
+		{[]byte("{{a}}"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := a.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != a.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, a.Valid,
+			)
+		}
+	}
+}
+
+func TestStringArray_UnmarshalJSON(t *testing.T) {
+	var a null.StringArray
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		value   []null.String
+		errType reflect.Type
+	}{
+		{[]byte(`["a",null,""]`), true, strs("a", nil, ""), nilType},
+		{[]byte("[]"), true, strs(), nilType},
+		{[]byte("null"), false, nil, nilType},
+		{[]byte("[1]"), false, nil, unmarshalErrType},
+		{[]byte(`"{a}"`), false, nil, unmarshalErrType},
+		{nil, false, nil, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := a.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != a.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, a.Valid,
+			)
+		}
+		if a.Valid && !reflect.DeepEqual(c.value, a.Array) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, a.Array,
+			)
+		}
+	}
+}
+
+func TestStringArray_Scan(t *testing.T) {
+	var a null.StringArray
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	dimErrType := reflect.TypeOf(null.DimensionError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{`{a,NULL}`, true, nilType},
+		{[]byte(`{a,NULL}`), true, nilType},
+		{nil, false, nilType},
+		{"", false, parseErrType},
+		{"{a", false, parseErrType},
+		{[]byte("{{a}}"), false, dimErrType},
+		{int64(1), false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := a.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != a.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, a.Valid,
+			)
+		}
+		if a.Valid && !reflect.DeepEqual(strs("a", nil), a.Array) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (got %v)",
+				t.Name(), n+1, a.Array,
+			)
+		}
+	}
+}
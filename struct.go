@@ -0,0 +1,274 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Struct implements a nullable T, where T is a struct type whose fields are
+// nullables, such as String or Of[int]. It is meant to hold the columns of
+// a related table fetched with a LEFT JOIN, which are all SQL NULL when the
+// related row does not exist: a Struct scanned through ScanDest becomes
+// invalid if all its fields are undefined, and it is then marshaled to the
+// JSON null value.
+type Struct[T any] struct {
+	// Val holds the underlying T value.
+	Val T
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// StructFrom creates a valid Struct from v.
+func StructFrom[T any](v T) Struct[T] {
+	return StructFromPtr(&v)
+}
+
+// StructFromPtr creates a Struct from pointer p. If p is nil,
+// the returned Struct is invalid.
+func StructFromPtr[T any](p *T) Struct[T] {
+	if p != nil {
+		return Struct[T]{
+			Val:   *p,
+			Valid: true,
+		}
+	}
+	return Struct[T]{}
+}
+
+// StructFromZero creates a Struct from v. If all the exported fields of v
+// are undefined, the returned Struct is invalid.
+func StructFromZero[T any](v T) Struct[T] {
+	return Struct[T]{
+		Val:   v,
+		Valid: !isNullStruct(v),
+	}
+}
+
+// Ptr returns a pointer to the underlying value of s if s is valid,
+// otherwise returns nil.
+func (s Struct[T]) Ptr() *T {
+	if s.Valid {
+		return &s.Val
+	}
+	return nil
+}
+
+// Zero returns the underlying value of s if s is valid, otherwise
+// returns the zero value of T.
+func (s Struct[T]) Zero() T {
+	if s.Valid {
+		return s.Val
+	}
+	var zero T
+	return zero
+}
+
+// From sets the underlying value of s to v. s becomes valid.
+func (s *Struct[T]) From(v T) {
+	s.Valid = true
+	s.Val = v
+}
+
+// FromPtr invalidates s if p is nil, otherwise it sets the underlying value
+// of s to the value pointed to by p, and s becomes valid.
+func (s *Struct[T]) FromPtr(p *T) {
+	s.Valid = p != nil
+	if p != nil {
+		s.Val = *p
+	}
+}
+
+// FromZero invalidates s if all the exported fields of v are undefined,
+// otherwise it sets the underlying value of s to v, and s becomes valid.
+func (s *Struct[T]) FromZero(v T) {
+	s.Valid = !isNullStruct(v)
+	s.Val = v
+}
+
+// IsValid returns true if s is valid.
+func (s Struct[T]) IsValid() bool {
+	return s.Valid
+}
+
+// Invalidate makes s invalid.
+func (s *Struct[T]) Invalidate() {
+	s.Valid = false
+}
+
+// Interface returns the underlying value of s as T if s is valid,
+// otherwise nil.
+func (s Struct[T]) Interface() interface{} {
+	if s.Valid {
+		return s.Val
+	}
+	return nil
+}
+
+// SetInterface invalidates s if v is nil, otherwise if v's type is T,
+// it sets the underlying value of s to v, and s becomes valid.
+// If v's type is any other type, s becomes invalid, and a TypeError is
+// returned.
+func (s *Struct[T]) SetInterface(v interface{}) error {
+	if v == nil {
+		s.Valid = false
+		return nil
+	}
+	value, ok := v.(T)
+	if !ok {
+		s.Valid = false
+		return makeTypeError("set", v, typeName(s.Val), "nil")
+	}
+	s.Val = value
+	s.Valid = true
+	return nil
+}
+
+// ScanDest returns a scan destination for each exported field of the
+// underlying value of s, in declaration order, so that the columns of the
+// related table can be passed to sql.Rows.Scan along with the other
+// columns:
+//
+//	var post struct {
+//	    Title  string
+//	    Author null.Struct[Author]
+//	}
+//	dest := append([]interface{}{&post.Title}, post.Author.ScanDest()...)
+//	err := rows.Scan(dest...)
+//
+// A field is scanned like Field.Scan does, and then s becomes invalid if all
+// the exported fields are undefined, otherwise it becomes valid. SQL NULL
+// cannot be scanned into a field which is neither a sql.Scanner nor a
+// pointer, map, slice or interface, such as an int64, like database/sql
+// does: s becomes invalid, and a ConversionError is returned, rather than
+// the field being silently set to its zero value. Columns which are NULL
+// when the related row does not exist must therefore be nullable fields.
+// ScanDest panics if T is not a struct type.
+func (s *Struct[T]) ScanDest() []interface{} {
+	v := reflect.ValueOf(&s.Val).Elem()
+	dest := make([]interface{}, 0, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).IsExported() {
+			dest = append(dest, structDest[T]{s, v.Field(i)})
+		}
+	}
+	return dest
+}
+
+// String returns a string representation of s. If s is valid,
+// it returns the underlying value of s formatted with fmt.Sprint,
+// otherwise it returns InvalidNullableString.
+func (s Struct[T]) String() string {
+	if s.Valid {
+		return fmt.Sprint(s.Val)
+	}
+	return InvalidNullableString
+}
+
+// MarshalJSON encodes the underlying value of s with json.Marshal if s is
+// valid, otherwise it returns the JSON null value. If the underlying value
+// of s cannot be encoded, a MarshalError is returned.
+func (s Struct[T]) MarshalJSON() (data []byte, err error) {
+	if !s.Valid {
+		return jNull, nil
+	}
+	bytes, err := json.Marshal(s.Val)
+	if err != nil {
+		return nil, makeMarshalError("json", s)
+	}
+	return bytes, nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to s.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, s becomes invalid. Otherwise, the encoded
+// JSON data is decoded with json.Unmarshal into a new T, which becomes the
+// underlying value of s, and s becomes valid. Malformed JSON, or JSON that
+// cannot be decoded into a T, produces an UnmarshalError.
+func (s *Struct[T]) UnmarshalJSON(data []byte) error {
+	var v T
+	if json.Unmarshal(data, &v) != nil {
+		s.Valid = false
+		return makeUnmarshalError("json", data, v)
+	}
+	s.Val = v
+	s.Valid = !bytes.Equal(bytes.TrimSpace(data), jNull)
+	return nil
+}
+
+// structDest is the scan destination of a field of a Struct.
+type structDest[T any] struct {
+	s     *Struct[T]
+	field reflect.Value
+}
+
+// Scan assigns a value from a database driver to the field of d, and
+// updates the validity of the related Struct. Errors returned by the field
+// are passed through. If obj is nil, and the field cannot hold SQL NULL,
+// the related Struct becomes invalid, and a ConversionError is returned.
+func (d structDest[T]) Scan(obj interface{}) error {
+	var err error
+	scanner, ok := d.field.Addr().Interface().(sql.Scanner)
+	switch {
+	case ok:
+		err = scanner.Scan(obj)
+	case obj == nil && !isNilable(d.field.Kind()):
+		d.s.Valid = false
+		return makeConversionError("sql", obj, d.field.Interface())
+	case obj == nil:
+		d.field.Set(reflect.Zero(d.field.Type()))
+	case d.field.Kind() == reflect.Ptr:
+		err = assignPtr("sql", d.field, obj)
+	default:
+		err = assignValue("sql", d.field, obj)
+	}
+	d.s.Valid = !isNullStruct(d.s.Val)
+	return err
+}
+
+// assignPtr allocates a new value for v, a pointer, assigns src to it with
+// its Scan method if it is a sql.Scanner, otherwise like assignValue does,
+// and then sets v to point to it. v is left unchanged if an error occurs.
+func assignPtr(prefix string, v reflect.Value, src interface{}) error {
+	p := reflect.New(v.Type().Elem())
+	var err error
+	if scanner, ok := p.Interface().(sql.Scanner); ok {
+		err = scanner.Scan(src)
+	} else {
+		err = assignValue(prefix, p.Elem(), src)
+	}
+	if err != nil {
+		return err
+	}
+	v.Set(p)
+	return nil
+}
+
+// isNilable reports whether values of kind k can be nil.
+func isNilable(k reflect.Kind) bool {
+	switch k {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return true
+	}
+	return false
+}
+
+// isNullStruct reports whether all the exported fields of v, a struct,
+// hold undefined values, as decided by isNull. If v is not a struct, it
+// reports whether v itself is undefined.
+func isNullStruct(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Struct {
+		return isNull(v)
+	}
+	for i := 0; i < rv.NumField(); i++ {
+		if rv.Type().Field(i).IsExported() && !isNull(rv.Field(i).Interface()) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,310 @@
+package null_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"null"
+	"reflect"
+	"testing"
+)
+
+type author struct {
+	ID    null.Int64
+	Name  null.String
+	Email *string
+	notes string
+}
+
+func TestStructFromZero(t *testing.T) {
+	email := "ada@example.com"
+	cases := []struct {
+		value author
+		valid bool
+	}{
+		{author{ID: null.Int64From(1)}, true},
+		{author{Name: null.StringFrom("")}, true},
+		{author{Email: &email}, true},
+		{author{notes: "x"}, false},
+		{author{}, false},
+	}
+
+	for n, c := range cases {
+		s := null.StructFromZero(c.value)
+		if c.valid != s.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, s.Valid,
+			)
+		}
+	}
+}
+
+func TestStruct_ScanDest(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	email := "x"
+
+	cases := []struct {
+		sources []interface{}
+		valid   bool
+		value   author
+		errType reflect.Type
+	}{
+		{
+			[]interface{}{int64(1), "Ada", nil},
+			true,
+			author{ID: null.Int64From(1), Name: null.StringFrom("Ada")},
+			nilType,
+		},
+		{
+			[]interface{}{nil, "", nil},
+			true,
+			author{Name: null.StringFrom("")},
+			nilType,
+		},
+		{[]interface{}{nil, nil, nil}, false, author{}, nilType},
+		{[]interface{}{nil, nil, "x"}, true, author{Email: &email}, nilType},
+		{[]interface{}{nil, nil, int64(1)}, false, author{}, typeErrType},
+	}
+
+	for n, c := range cases {
+		s := null.StructFrom(author{notes: "x"})
+		dest := s.ScanDest()
+		if len(dest) != 3 {
+			t.Fatalf(
+				"%s, case #%d: length mismatch (expected 3, got %d)",
+				t.Name(), n+1, len(dest),
+			)
+		}
+		var err error
+		for i, d := range dest {
+			if e := d.(sql.Scanner).Scan(c.sources[i]); e != nil {
+				err = e
+			}
+		}
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != s.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, s.Valid,
+			)
+		}
+		c.value.notes = "x"
+		if s.Valid && !reflect.DeepEqual(c.value, s.Val) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, s.Val,
+			)
+		}
+	}
+}
+
+func TestStruct_ScanDestMixed(t *testing.T) {
+	type book struct {
+		ID    int64
+		Title null.String
+	}
+	convErrType := reflect.TypeOf(null.ConversionError{})
+
+	cases := []struct {
+		sources []interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]interface{}{int64(0), nil}, true, nil},
+		{[]interface{}{int64(1), "Dune"}, true, nil},
+		{[]interface{}{nil, nil}, false, convErrType},
+	}
+
+	for n, c := range cases {
+		var s null.Struct[book]
+		var err error
+		for i, d := range s.ScanDest() {
+			if err = d.(sql.Scanner).Scan(c.sources[i]); err != nil {
+				break
+			}
+		}
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != s.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, s.Valid,
+			)
+		}
+	}
+}
+
+func TestStruct_ScanDestPtr(t *testing.T) {
+	type user struct {
+		Name *string
+		Nick *null.String
+	}
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	name, nick := "bob", null.StringFrom("b")
+
+	cases := []struct {
+		sources []interface{}
+		name    *string
+		nick    *null.String
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]interface{}{nil, nil}, nil, nil, false, nil},
+		{
+			[]interface{}{"bob", "b"},
+			&name, &nick, true, nil,
+		},
+		{[]interface{}{[]byte("bob"), nil}, &name, nil, true, nil},
+		{[]interface{}{int64(1), nil}, nil, nil, false, typeErrType},
+	}
+
+	for n, c := range cases {
+		var s null.Struct[user]
+		var err error
+		for i, d := range s.ScanDest() {
+			if err = d.(sql.Scanner).Scan(c.sources[i]); err != nil {
+				break
+			}
+		}
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != s.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, s.Valid,
+			)
+		}
+		exp := user{c.name, c.nick}
+		if !reflect.DeepEqual(exp, s.Val) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, exp, s.Val,
+			)
+		}
+	}
+}
+
+func TestStruct_String(t *testing.T) {
+	cases := []struct {
+		nullable null.Struct[author]
+		string   string
+	}{
+		{
+			null.StructFrom(author{ID: null.Int64From(1)}),
+			"{1 <invalid> <nil> }",
+		},
+		{null.Struct[author]{}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestStruct_MarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable interface{ MarshalJSON() ([]byte, error) }
+		json     []byte
+		errType  reflect.Type
+	}{
+		{
+			null.StructFrom(author{ID: null.Int64From(1)}),
+			[]byte(`{"ID":1,"Name":null,"Email":null}`),
+			nilType,
+		},
+		{null.StructFromZero(author{}), []byte("null"), nilType},
+		{null.StructFrom(struct{ C chan int }{}), nil, marshalErrType},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+
+	b, err := json.Marshal(struct {
+		Title  string              `json:"title"`
+		Author null.Struct[author] `json:"author"`
+	}{"Notes", null.Struct[author]{}})
+	if err != nil || string(b) != `{"title":"Notes","author":null}` {
+		t.Fatalf("%s: embedding mismatch (got '%s', %v)", t.Name(), b, err)
+	}
+}
+
+func TestStruct_UnmarshalJSON(t *testing.T) {
+	var s null.Struct[author]
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		value   author
+		errType reflect.Type
+	}{
+		{
+			[]byte(`{"ID":1,"Name":"Ada"}`),
+			true,
+			author{ID: null.Int64From(1), Name: null.StringFrom("Ada")},
+			nilType,
+		},
+		{[]byte(`{}`), true, author{}, nilType},
+		{[]byte("null"), false, author{}, nilType},
+		{[]byte(`{"ID":"x"}`), false, author{}, unmarshalErrType},
+		{[]byte("["), false, author{}, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := s.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != s.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, s.Valid,
+			)
+		}
+		if s.Valid && !reflect.DeepEqual(c.value, s.Val) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, s.Val,
+			)
+		}
+	}
+}
@@ -82,6 +82,80 @@ func (t *Time) FromZero(v time.Time) {
 	t.Time = v
 }
 
+// IsValid returns true if t is valid.
+func (t Time) IsValid() bool {
+	return t.Valid
+}
+
+// Invalidate makes t invalid.
+func (t *Time) Invalidate() {
+	t.Valid = false
+}
+
+// Interface returns the underlying value of t as time.Time if t is valid,
+// otherwise nil.
+func (t Time) Interface() interface{} {
+	if t.Valid {
+		return t.Time
+	}
+	return nil
+}
+
+// SetInterface invalidates t if v is nil, otherwise if v's type is time.Time,
+// it sets the underlying value of t to v, and t becomes valid.
+// If v's type is any other type, t becomes invalid, and a TypeError is
+// returned.
+func (t *Time) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case time.Time:
+		t.Time = value
+		t.Valid = true
+		return nil
+	case nil:
+		t.Valid = false
+		return nil
+	default:
+		t.Valid = false
+		return makeTypeError("set", value, "time.Time", "nil")
+	}
+}
+
+// Unix converts t to a UnixTime with the same underlying value and validity.
+func (t Time) Unix() UnixTime {
+	return UnixTime{
+		Time:  t.Time,
+		Valid: t.Valid,
+	}
+}
+
+// UnixMilli converts t to a UnixMilli with the same underlying value and
+// validity.
+func (t Time) UnixMilli() UnixMilli {
+	return UnixMilli{
+		Time:  t.Time,
+		Valid: t.Valid,
+	}
+}
+
+// UnixNano converts t to a UnixNano with the same underlying value and
+// validity.
+func (t Time) UnixNano() UnixNano {
+	return UnixNano{
+		Time:  t.Time,
+		Valid: t.Valid,
+	}
+}
+
+// In returns t with its underlying value converted to the underlying value
+// of loc, like time.Time.In does, if t and loc are valid. If either is
+// invalid, t is returned unchanged.
+func (t Time) In(loc Location) Time {
+	if t.Valid && loc.Valid {
+		t.Time = t.Time.In(loc.loc())
+	}
+	return t
+}
+
 // String returns a string representation of t. If t is valid,
 // it formats the underlying value of t according to the RFC3339 standard with
 // nanoseconds. For time instants which year is beyond 10000, not allowed by the
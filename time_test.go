@@ -298,6 +298,46 @@ func TestTime_FromZero(t *testing.T) {
 	}
 }
 
+func TestTime_In(t *testing.T) {
+	rome, err := time.LoadLocation("Europe/Rome")
+	if err != nil {
+		t.Fatalf("%s: cannot load location: %v", t.Name(), err)
+	}
+	instant := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		nullable null.Time
+		loc      null.Location
+		valid    bool
+		location *time.Location
+	}{
+		{null.TimeFrom(instant), null.LocationFrom(rome), true, rome},
+		{null.TimeFrom(instant), null.Location{Valid: true}, true, time.UTC},
+		{null.TimeFrom(instant), null.Location{}, true, time.UTC},
+		{null.Time{}, null.LocationFrom(rome), false, nil},
+	}
+
+	for n, c := range cases {
+		tm := c.nullable.In(c.loc)
+		if c.valid != tm.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, tm.Valid,
+			)
+		}
+		if !tm.Valid {
+			continue
+		}
+
+		if !instant.Equal(tm.Time) || c.location != tm.Time.Location() {
+			t.Fatalf(
+				"%s, case #%d: time mismatch (expected %s, got %s)",
+				t.Name(), n+1, instant.In(c.location), tm.Time,
+			)
+		}
+	}
+}
+
 func TestTime_String(t *testing.T) {
 	zero := time.Time{}
 	now := time.Now()
@@ -0,0 +1,282 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// timeOfDayLayout is the layout of TimeOfDay string representations.
+const timeOfDayLayout = "15:04:05.999999999"
+
+// TimeOfDay implements a nullable time of day, that is, a time without a
+// date and without a time zone. It is meant to be used with SQL TIME columns.
+type TimeOfDay struct {
+	// Hour holds the hour of the underlying time of day, in [0, 23].
+	Hour int
+
+	// Minute holds the minute of the underlying time of day, in [0, 59].
+	Minute int
+
+	// Second holds the second of the underlying time of day, in [0, 59].
+	Second int
+
+	// Nanosecond holds the nanosecond of the underlying time of day,
+	// in [0, 999999999].
+	Nanosecond int
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// TimeOfDayFrom creates a valid TimeOfDay from the clock of v, as seen in v's
+// location.
+func TimeOfDayFrom(v time.Time) TimeOfDay {
+	return TimeOfDayFromPtr(&v)
+}
+
+// TimeOfDayFromPtr creates a TimeOfDay from the clock of the time.Time
+// pointed to by p, as seen in its location. If p is nil,
+// the returned TimeOfDay is invalid.
+func TimeOfDayFromPtr(p *time.Time) TimeOfDay {
+	if p != nil {
+		hour, min, sec := p.Clock()
+		return TimeOfDay{
+			Hour:       hour,
+			Minute:     min,
+			Second:     sec,
+			Nanosecond: p.Nanosecond(),
+			Valid:      true,
+		}
+	}
+	return TimeOfDay{}
+}
+
+// TimeOfDayFromZero creates a TimeOfDay from the clock of v, as seen in v's
+// location. If v represents the zero time instant, the returned TimeOfDay is
+// invalid.
+func TimeOfDayFromZero(v time.Time) TimeOfDay {
+	t := TimeOfDayFrom(v)
+	t.Valid = !v.IsZero()
+	return t
+}
+
+// Ptr returns a pointer to a time.Time representing the underlying time of
+// day of t on January 1, year 0, UTC if t is valid, otherwise returns nil.
+func (t TimeOfDay) Ptr() *time.Time {
+	if t.Valid {
+		v := t.time()
+		return &v
+	}
+	return nil
+}
+
+// Zero returns a time.Time representing the underlying time of day of t on
+// January 1, year 0, UTC if t is valid, otherwise returns a time.Time
+// representing the zero time instant.
+func (t TimeOfDay) Zero() time.Time {
+	if t.Valid {
+		return t.time()
+	}
+	return time.Time{}
+}
+
+// From sets the underlying time of day of t to the clock of v, as seen in
+// v's location. t becomes valid.
+func (t *TimeOfDay) From(v time.Time) {
+	*t = TimeOfDayFrom(v)
+}
+
+// FromPtr invalidates t if p is nil, otherwise it sets the underlying time
+// of day of t to the clock of the time.Time pointed to by p,
+// and t becomes valid.
+func (t *TimeOfDay) FromPtr(p *time.Time) {
+	t.Valid = p != nil
+	if p != nil {
+		t.From(*p)
+	}
+}
+
+// FromZero invalidates t if v represents the zero time instant,
+// otherwise it sets the underlying time of day of t to the clock of v,
+// and t becomes valid.
+func (t *TimeOfDay) FromZero(v time.Time) {
+	*t = TimeOfDayFromZero(v)
+}
+
+// IsValid returns true if t is valid.
+func (t TimeOfDay) IsValid() bool {
+	return t.Valid
+}
+
+// Invalidate makes t invalid.
+func (t *TimeOfDay) Invalidate() {
+	t.Valid = false
+}
+
+// Interface returns a time.Time representing the underlying time of day of
+// t on January 1, year 0, UTC if t is valid, otherwise nil.
+func (t TimeOfDay) Interface() interface{} {
+	if t.Valid {
+		return t.time()
+	}
+	return nil
+}
+
+// SetInterface invalidates t if v is nil, otherwise if v's type is
+// time.Time, it sets the underlying time of day of t to the clock of v,
+// and t becomes valid. If v's type is any other type, t becomes invalid,
+// and a TypeError is returned.
+func (t *TimeOfDay) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case time.Time:
+		t.From(value)
+		return nil
+	case nil:
+		t.Valid = false
+		return nil
+	default:
+		t.Valid = false
+		return makeTypeError("set", value, "time.Time", "nil")
+	}
+}
+
+// String returns a string representation of t. If t is valid,
+// it formats the underlying time of day of t as 15:04:05.999999999,
+// where trailing zeros of the fractional second are omitted,
+// otherwise it returns InvalidNullableString.
+func (t TimeOfDay) String() string {
+	if t.Valid {
+		return t.time().Format(timeOfDayLayout)
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals t to a byte string representation. If t is valid, it
+// formats the underlying time of day of t as 15:04:05.999999999,
+// otherwise it returns nil. err is always nil.
+func (t TimeOfDay) MarshalText() (data []byte, err error) {
+	if t.Valid {
+		return []byte(t.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying time of day of t to a JSON string
+// formatted as 15:04:05.999999999 if t is valid, otherwise it returns the
+// JSON null value. err is always nil.
+func (t TimeOfDay) MarshalJSON() (data []byte, err error) {
+	if t.Valid {
+		return []byte(`"` + t.String() + `"`), nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying time of day of t formatted as
+// 15:04:05.999999999 if t is valid, otherwise nil. err is always nil.
+func (t TimeOfDay) Value() (v driver.Value, err error) {
+	if t.Valid {
+		return t.String(), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates t if str is the empty string, otherwise it parses str into
+// the underlying time of day of t, and t becomes valid. If str is not a valid
+// time of day formatted as 15:04:05, optionally followed by a fractional
+// second, t becomes invalid and a ParseError is returned.
+func (t *TimeOfDay) Set(str string) error {
+	v, err := time.Parse(timeOfDayLayout, str)
+	t.From(v)
+	t.Valid = err == nil && str != ""
+	if str == "" || err == nil {
+		return nil
+	}
+
+	return makeParseError("parse", str, *t)
+}
+
+// UnmarshalText unmarshals from a byte string to t.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	if t.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *t)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to t.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, t becomes invalid. If the encoded JSON data
+// represent a JSON string formatted as 15:04:05.999999999, t becomes valid,
+// and the underlying time of day of t is set to the JSON string. If the
+// encoded JSON data represent a JSON string, but not formatted as
+// 15:04:05.999999999, a ParseError is returned. Other JSON types produce a
+// TypeError. Malformed JSON produces an UnmarshalError.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	var obj interface{}
+	if json.Unmarshal(data, &obj) != nil {
+		t.Valid = false
+		return makeUnmarshalError("json", data, *t)
+	}
+	switch value := obj.(type) {
+	case string:
+		if t.Set(value) != nil || value == "" {
+			t.Valid = false
+			return makeParseError("parse", value, *t)
+		}
+		return nil
+	case nil:
+		t.Valid = false
+		return nil
+	default:
+		t.Valid = false
+		return makeTypeError("json", value, "string", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is time.Time,
+// t becomes valid, and the underlying time of day of t becomes the clock of
+// obj, as seen in obj's location. If obj's type is string or []byte, and it
+// is formatted as 15:04:05.999999999, t becomes valid, and the underlying
+// time of day of t is set accordingly, otherwise t becomes invalid,
+// and a ParseError is returned. If obj is nil, t becomes invalid.
+// If obj's type is any other type, t becomes invalid,
+// and a TypeError is returned.
+func (t *TimeOfDay) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case time.Time:
+		t.From(value)
+		return nil
+	case string:
+		return t.scanString(value)
+	case []byte:
+		return t.scanString(string(value))
+	case nil:
+		t.Valid = false
+		return nil
+	default:
+		t.Valid = false
+		return makeTypeError("sql", value, "time.Time", "string", "[]byte",
+			"nil")
+	}
+}
+
+// scanString parses a time of day returned by a database driver as a string.
+// Unlike Set, the empty string is not accepted.
+func (t *TimeOfDay) scanString(str string) error {
+	if t.Set(str) != nil || str == "" {
+		t.Valid = false
+		return makeParseError("sql", str, *t)
+	}
+	return nil
+}
+
+// time returns a time.Time representing the underlying time of day of t on
+// January 1, year 0, UTC, regardless of its validity.
+func (t TimeOfDay) time() time.Time {
+	return time.Date(0, time.January, 1, t.Hour, t.Minute, t.Second,
+		t.Nanosecond, time.UTC)
+}
@@ -0,0 +1,345 @@
+package null_test
+
+import (
+	"null"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTimeOfDayFrom(t *testing.T) {
+	cet := time.FixedZone("CET", 3600)
+
+	cases := []struct {
+		time      time.Time
+		timeOfDay null.TimeOfDay
+	}{
+		{
+			time.Date(2019, 8, 4, 15, 4, 5, 0, time.UTC),
+			null.TimeOfDay{Hour: 15, Minute: 4, Second: 5, Valid: true},
+		},
+		{
+			time.Date(2019, 8, 4, 23, 59, 59, 999, cet),
+			null.TimeOfDay{
+				Hour: 23, Minute: 59, Second: 59, Nanosecond: 999,
+				Valid: true,
+			},
+		},
+	}
+
+	for n, c := range cases {
+		tod := null.TimeOfDayFrom(c.time)
+		if c.timeOfDay != tod {
+			t.Fatalf(
+				"%s, case #%d: time of day mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.timeOfDay, tod,
+			)
+		}
+	}
+
+	if null.TimeOfDayFromPtr(nil).Valid {
+		t.Fatalf("%s: nil pointer produced a valid time of day", t.Name())
+	}
+	if null.TimeOfDayFromZero(time.Time{}).Valid {
+		t.Fatalf("%s: zero time produced a valid time of day", t.Name())
+	}
+}
+
+func TestTimeOfDay_Zero(t *testing.T) {
+	cases := []struct {
+		nullable null.TimeOfDay
+		time     time.Time
+	}{
+		{
+			null.TimeOfDay{Hour: 15, Minute: 4, Valid: true},
+			time.Date(0, 1, 1, 15, 4, 0, 0, time.UTC),
+		},
+		{null.TimeOfDay{}, time.Time{}},
+		{null.TimeOfDay{Hour: 15}, time.Time{}},
+	}
+
+	for n, c := range cases {
+		if !c.time.Equal(c.nullable.Zero()) {
+			t.Fatalf(
+				"%s, case #%d: time mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.time, c.nullable.Zero(),
+			)
+		}
+		p := c.nullable.Ptr()
+		if c.nullable.Valid != (p != nil) {
+			t.Fatalf("%s, case #%d: wrong pointer returned", t.Name(), n+1)
+		}
+	}
+}
+
+func TestTimeOfDay_String(t *testing.T) {
+	cases := []struct {
+		nullable null.TimeOfDay
+		string   string
+	}{
+		{
+			null.TimeOfDay{Hour: 15, Minute: 4, Second: 5, Valid: true},
+			"15:04:05",
+		},
+		{
+			null.TimeOfDay{
+				Hour: 9, Nanosecond: 500000000, Valid: true,
+			},
+			"09:00:00.5",
+		},
+		{
+			null.TimeOfDay{
+				Hour: 23, Minute: 59, Second: 59, Nanosecond: 999999999,
+				Valid: true,
+			},
+			"23:59:59.999999999",
+		},
+		{null.TimeOfDay{}, "<invalid>"},
+		{null.TimeOfDay{Hour: 15}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestTimeOfDay_MarshalText(t *testing.T) {
+	cases := []struct {
+		nullable null.TimeOfDay
+		bytes    []byte
+	}{
+		{null.TimeOfDay{Hour: 15, Valid: true}, []byte("15:00:00")},
+		{null.TimeOfDay{}, nil},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalText()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.bytes, b) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(b),
+			)
+		}
+	}
+}
+
+func TestTimeOfDay_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		nullable null.TimeOfDay
+		json     []byte
+	}{
+		{null.TimeOfDay{Hour: 15, Valid: true}, []byte(`"15:00:00"`)},
+		{null.TimeOfDay{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestTimeOfDay_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.TimeOfDay
+		value    interface{}
+	}{
+		{null.TimeOfDay{Hour: 15, Minute: 30, Valid: true}, "15:30:00"},
+		{null.TimeOfDay{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestTimeOfDay_Set(t *testing.T) {
+	var tod null.TimeOfDay
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string    string
+		timeOfDay null.TimeOfDay
+		errType   reflect.Type
+	}{
+		{
+			"15:04:05",
+			null.TimeOfDay{Hour: 15, Minute: 4, Second: 5, Valid: true},
+			nilType,
+		},
+		{
+			"15:04:05.25",
+			null.TimeOfDay{
+				Hour: 15, Minute: 4, Second: 5, Nanosecond: 250000000,
+				Valid: true,
+			},
+			nilType,
+		},
+		{"", null.TimeOfDay{}, nilType},
+		{"24:00:00", null.TimeOfDay{}, parseErrType},
+		{"15:04", null.TimeOfDay{}, parseErrType},
+		{"x", null.TimeOfDay{}, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := tod.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.timeOfDay.Valid != tod.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.timeOfDay.Valid, tod.Valid,
+			)
+		}
+		if tod.Valid && c.timeOfDay != tod {
+			t.Fatalf(
+				"%s, case #%d: time of day mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.timeOfDay, tod,
+			)
+		}
+	}
+}
+
+func TestTimeOfDay_UnmarshalText(t *testing.T) {
+	var tod null.TimeOfDay
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("15:04:05"), true, nilType},
+		{nil, false, nilType},
+		{[]byte(""), false, nilType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := tod.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != tod.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, tod.Valid,
+			)
+		}
+	}
+}
+
+func TestTimeOfDay_UnmarshalJSON(t *testing.T) {
+	var tod null.TimeOfDay
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`"15:04:05.999"`), true, nilType},
+		{[]byte("null"), false, nilType},
+		{[]byte(`""`), false, parseErrType},
+		{[]byte(`"x"`), false, parseErrType},
+		{[]byte("1"), false, typeErrType},
+		{nil, false, unmarshalErrType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := tod.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != tod.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, tod.Valid,
+			)
+		}
+	}
+}
+
+func TestTimeOfDay_Scan(t *testing.T) {
+	var tod null.TimeOfDay
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	exp := null.TimeOfDay{Hour: 8, Minute: 30, Valid: true}
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{time.Date(0, 1, 1, 8, 30, 0, 0, time.UTC), true, nilType},
+		{"08:30:00", true, nilType},
+		{[]byte("08:30:00"), true, nilType},
+		{nil, false, nilType},
+		{"", false, parseErrType},
+		{[]byte("x"), false, parseErrType},
+		{int64(1), false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := tod.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != tod.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, tod.Valid,
+			)
+		}
+		if tod.Valid && exp != tod {
+			t.Fatalf(
+				"%s, case #%d: time of day mismatch (expected %v, got %v)",
+				t.Name(), n+1, exp, tod,
+			)
+		}
+	}
+}
@@ -0,0 +1,325 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// TimeRange implements a nullable range of time instants, meant to be used
+// with PostgreSQL tstzrange and tsrange columns. It is represented in text
+// and SQL by a PostgreSQL range literal whose bounds are formatted according
+// to the RFC3339 standard with nanoseconds, such as
+// [2024-01-01T10:00:00Z,2024-01-01T12:00:00Z), and in JSON by an object such
+// as {"lower":"2024-01-01T10:00:00Z","upper":null,"lower_inc":true,
+// "upper_inc":false} or {"empty":true}.
+type TimeRange struct {
+	// Lower holds the lower bound of the range. If Lower is invalid, the
+	// range has no lower bound.
+	Lower Time
+
+	// Upper holds the upper bound of the range. If Upper is invalid, the
+	// range has no upper bound.
+	Upper Time
+
+	// LowerInc is true if the lower bound is inclusive. It is ignored if
+	// Lower is invalid.
+	LowerInc bool
+
+	// UpperInc is true if the upper bound is inclusive. It is ignored if
+	// Upper is invalid.
+	UpperInc bool
+
+	// Empty is true if the range holds no values, in which case the bounds
+	// are ignored.
+	Empty bool
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// TimeRangeFrom creates a valid TimeRange holding the instants from lower,
+// inclusive, to upper, exclusive. An invalid bound makes the range unbounded
+// on that side.
+func TimeRangeFrom(lower, upper Time) TimeRange {
+	return TimeRange{
+		Lower:    lower,
+		Upper:    upper,
+		LowerInc: true,
+		Valid:    true,
+	}
+}
+
+// IsValid returns true if r is valid.
+func (r TimeRange) IsValid() bool {
+	return r.Valid
+}
+
+// Invalidate makes r invalid.
+func (r *TimeRange) Invalidate() {
+	r.Valid = false
+}
+
+// Interface returns the range literal of r as a string if r is valid,
+// otherwise nil.
+func (r TimeRange) Interface() interface{} {
+	if r.Valid {
+		return r.literal()
+	}
+	return nil
+}
+
+// SetInterface invalidates r if v is nil, otherwise if v's type is string,
+// it behaves like Set, except that the empty string produces a ParseError.
+// If v's type is any other type, r becomes invalid, and a TypeError is
+// returned.
+func (r *TimeRange) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case string:
+		if r.Set(value) != nil || value == "" {
+			r.Valid = false
+			return makeParseError("set", value, *r)
+		}
+		return nil
+	case nil:
+		r.Valid = false
+		return nil
+	default:
+		r.Valid = false
+		return makeTypeError("set", value, "string", "nil")
+	}
+}
+
+// Contains returns true if r is valid, and v lies within the bounds of r.
+// An empty range contains no instants.
+func (r TimeRange) Contains(v time.Time) bool {
+	if !r.Valid || r.Empty {
+		return false
+	}
+	b := rangeBound[time.Time]{val: v, ok: true, inc: true}
+	lower, upper := r.bounds()
+	return lower.below(b, time.Time.Compare) &&
+		b.below(upper, time.Time.Compare)
+}
+
+// Overlaps returns true if r and o are both valid, and they have at least
+// one instant in common.
+func (r TimeRange) Overlaps(o TimeRange) bool {
+	if !r.Valid || !o.Valid || r.Empty || o.Empty {
+		return false
+	}
+	rl, ru := r.bounds()
+	ol, ou := o.bounds()
+	return rl.below(ru, time.Time.Compare) &&
+		ol.below(ou, time.Time.Compare) &&
+		rl.below(ou, time.Time.Compare) &&
+		ol.below(ru, time.Time.Compare)
+}
+
+// String returns a string representation of r. If r is valid,
+// it returns the PostgreSQL range literal of r, otherwise it returns
+// InvalidNullableString.
+func (r TimeRange) String() string {
+	if r.Valid {
+		return r.literal()
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals r to a byte string representation. If r is valid,
+// it returns the PostgreSQL range literal of r, otherwise it returns nil.
+// err is always nil.
+func (r TimeRange) MarshalText() (data []byte, err error) {
+	if r.Valid {
+		return []byte(r.literal()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes r to a JSON object holding its bounds and their
+// inclusivity if r is valid, otherwise it returns the JSON null value.
+// Missing bounds are encoded as the JSON null value, and an empty range is
+// encoded as {"empty":true}. If a bound cannot be marshaled, a MarshalError
+// is returned.
+func (r TimeRange) MarshalJSON() (data []byte, err error) {
+	if !r.Valid {
+		return jNull, nil
+	}
+	if r.Empty {
+		return jEmptyRange, nil
+	}
+	lowerInc := r.LowerInc && r.Lower.Valid
+	upperInc := r.UpperInc && r.Upper.Valid
+	bytes, err := json.Marshal(rangeJSON[Time]{
+		Lower:    r.Lower,
+		Upper:    r.Upper,
+		LowerInc: &lowerInc,
+		UpperInc: &upperInc,
+	})
+	if err != nil {
+		return nil, makeMarshalError("json", r)
+	}
+	return bytes, nil
+}
+
+// Value returns the PostgreSQL range literal of r if r is valid,
+// otherwise nil. err is always nil.
+func (r TimeRange) Value() (v driver.Value, err error) {
+	if r.Valid {
+		return r.literal(), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates r if str is the empty string, otherwise it parses str,
+// which holds a PostgreSQL range literal, into r, and r becomes valid.
+// Bounds are formatted according to the RFC3339 standard, or like PostgreSQL
+// formats timestamptz and timestamp values, such as 2024-01-01 10:00:00+00.
+// Bounds without a time zone are taken as UTC. The infinity and -infinity
+// bounds make r unbounded on the related side. If str cannot be parsed,
+// r becomes invalid and a ParseError is returned.
+func (r *TimeRange) Set(str string) error {
+	if str == "" {
+		r.Valid = false
+		return nil
+	}
+	if !r.parse(str) {
+		return makeParseError("parse", str, *r)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to r.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (r *TimeRange) UnmarshalText(text []byte) error {
+	if r.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *r)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to r.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, r becomes invalid. If the encoded JSON data
+// represent a JSON object, r becomes valid, and its bounds are set from the
+// lower and upper members, which are unmarshaled like Time.UnmarshalJSON
+// does. lower_inc defaults to true, and upper_inc to false. If the empty
+// member is true, r becomes an empty range. Malformed JSON, other JSON
+// types, or bounds which Time.UnmarshalJSON rejects produce an
+// UnmarshalError.
+func (r *TimeRange) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jNull) {
+		r.Valid = false
+		return nil
+	}
+	var v rangeJSON[Time]
+	if json.Unmarshal(data, &v) != nil {
+		r.Valid = false
+		return makeUnmarshalError("json", data, *r)
+	}
+	*r = TimeRange{Lower: v.Lower, Upper: v.Upper, Empty: v.Empty, Valid: true}
+	r.LowerInc = v.Lower.Valid && (v.LowerInc == nil || *v.LowerInc)
+	r.UpperInc = v.Upper.Valid && v.UpperInc != nil && *v.UpperInc
+	return nil
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is parsed like Set does, except that the empty string produces
+// a ParseError. If obj is nil, r becomes invalid. If obj's type is any other
+// type, r becomes invalid, and a TypeError is returned.
+func (r *TimeRange) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case string:
+		return r.scanString(value)
+	case []byte:
+		return r.scanString(string(value))
+	case nil:
+		r.Valid = false
+		return nil
+	default:
+		r.Valid = false
+		return makeTypeError("sql", value, "string", "[]byte", "nil")
+	}
+}
+
+// scanString parses a range returned by a database driver as a string.
+// Unlike Set, the empty string is not accepted.
+func (r *TimeRange) scanString(str string) error {
+	if str == "" || !r.parse(str) {
+		r.Valid = false
+		return makeParseError("sql", str, *r)
+	}
+	return nil
+}
+
+// literal returns the PostgreSQL range literal of r.
+func (r TimeRange) literal() string {
+	return formatRange(rangeLiteral{
+		lower:    r.Lower.Time.Format(time.RFC3339Nano),
+		upper:    r.Upper.Time.Format(time.RFC3339Nano),
+		hasLower: r.Lower.Valid,
+		hasUpper: r.Upper.Valid,
+		lowerInc: r.LowerInc,
+		upperInc: r.UpperInc,
+		empty:    r.Empty,
+	})
+}
+
+// parse parses str as a PostgreSQL range literal into r, and returns the
+// validity of r.
+func (r *TimeRange) parse(str string) bool {
+	lit, ok := parseRange(str)
+	*r = TimeRange{
+		LowerInc: lit.lowerInc,
+		UpperInc: lit.upperInc,
+		Empty:    lit.empty,
+	}
+	if ok && lit.hasLower {
+		r.Lower, ok = parseRangeTime(lit.lower)
+		r.LowerInc = r.LowerInc && r.Lower.Valid
+	}
+	if ok && lit.hasUpper {
+		r.Upper, ok = parseRangeTime(lit.upper)
+		r.UpperInc = r.UpperInc && r.Upper.Valid
+	}
+	r.Valid = ok
+	return ok
+}
+
+// bounds returns the bounds of r.
+func (r TimeRange) bounds() (lower, upper rangeBound[time.Time]) {
+	lower = rangeBound[time.Time]{r.Lower.Time, r.Lower.Valid, r.LowerInc}
+	upper = rangeBound[time.Time]{r.Upper.Time, r.Upper.Valid, r.UpperInc}
+	return lower, upper
+}
+
+// rangeTimeLayouts holds the layouts accepted for the bounds of a
+// TimeRange, besides time.RFC3339Nano. Fractional seconds are accepted
+// even if the layouts omit them.
+var rangeTimeLayouts = []string{
+	"2006-01-02 15:04:05Z07",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05Z07:00:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+}
+
+// parseRangeTime parses the bound of a time range. The infinity and
+// -infinity bounds are returned as invalid Time values, with ok set to true.
+func parseRangeTime(str string) (t Time, ok bool) {
+	if str == "infinity" || str == "-infinity" {
+		return Time{}, true
+	}
+	if v, err := time.Parse(time.RFC3339Nano, str); err == nil {
+		return TimeFrom(v), true
+	}
+	for _, layout := range rangeTimeLayouts {
+		if v, err := time.Parse(layout, str); err == nil {
+			return TimeFrom(v), true
+		}
+	}
+	return Time{}, false
+}
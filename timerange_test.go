@@ -0,0 +1,389 @@
+package null_test
+
+import (
+	"null"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// tr builds a TimeRange from a PostgreSQL range literal.
+func tr(str string) null.TimeRange {
+	var r null.TimeRange
+	if err := r.Set(str); err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// utc returns the instant of the given day and hour in UTC.
+func utc(day, hour int) time.Time {
+	return time.Date(2024, time.January, day, hour, 0, 0, 0, time.UTC)
+}
+
+func TestTimeRange_Contains(t *testing.T) {
+	cases := []struct {
+		nullable null.TimeRange
+		value    time.Time
+		contains bool
+	}{
+		{tr("[2024-01-01T10:00:00Z,2024-01-01T12:00:00Z)"), utc(1, 10), true},
+		{tr("[2024-01-01T10:00:00Z,2024-01-01T12:00:00Z)"), utc(1, 11), true},
+		{tr("[2024-01-01T10:00:00Z,2024-01-01T12:00:00Z)"), utc(1, 12), false},
+		{tr("(2024-01-01T10:00:00Z,2024-01-01T12:00:00Z]"), utc(1, 10), false},
+		{tr("(2024-01-01T10:00:00Z,2024-01-01T12:00:00Z]"), utc(1, 12), true},
+		{tr("[2024-01-01T11:00:00+01:00,)"), utc(1, 10), true},
+		{tr("[2024-01-01T11:00:00+01:00,)"), utc(1, 9), false},
+		{tr("(,2024-01-01T12:00:00Z)"), time.Time{}, true},
+		{tr("(-infinity,infinity)"), utc(1, 0), true},
+		{tr("empty"), utc(1, 0), false},
+		{null.TimeRange{}, utc(1, 0), false},
+	}
+
+	for n, c := range cases {
+		if c.contains != c.nullable.Contains(c.value) {
+			t.Fatalf(
+				"%s, case #%d: containment mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.contains, !c.contains,
+			)
+		}
+	}
+}
+
+func TestTimeRange_Overlaps(t *testing.T) {
+	cases := []struct {
+		a, b     null.TimeRange
+		overlaps bool
+	}{
+		{
+			tr("[2024-01-01T10:00:00Z,2024-01-01T12:00:00Z)"),
+			tr("[2024-01-01T11:00:00Z,2024-01-01T13:00:00Z)"),
+			true,
+		},
+		{
+			tr("[2024-01-01T10:00:00Z,2024-01-01T12:00:00Z)"),
+			tr("[2024-01-01T12:00:00Z,2024-01-01T13:00:00Z)"),
+			false,
+		},
+		{
+			tr("[2024-01-01T10:00:00Z,2024-01-01T12:00:00Z]"),
+			tr("[2024-01-01T12:00:00Z,2024-01-01T13:00:00Z)"),
+			true,
+		},
+		{
+			tr("[2024-01-01T10:00:00Z,2024-01-01T10:00:00Z)"),
+			tr("(,)"),
+			false,
+		},
+		{tr("(,2024-01-01T10:00:00Z)"), tr("[2024-01-01T09:00:00Z,)"), true},
+		{tr("empty"), tr("(,)"), false},
+		{null.TimeRange{}, tr("(,)"), false},
+	}
+
+	for n, c := range cases {
+		if c.overlaps != c.a.Overlaps(c.b) || c.overlaps != c.b.Overlaps(c.a) {
+			t.Fatalf(
+				"%s, case #%d: overlap mismatch (expected %t)",
+				t.Name(), n+1, c.overlaps,
+			)
+		}
+	}
+}
+
+func TestTimeRange_String(t *testing.T) {
+	cases := []struct {
+		nullable null.TimeRange
+		string   string
+	}{
+		{
+			null.TimeRangeFrom(null.TimeFrom(utc(1, 10)), null.TimeFrom(utc(2, 0))),
+			"[2024-01-01T10:00:00Z,2024-01-02T00:00:00Z)",
+		},
+		{
+			null.TimeRangeFrom(null.Time{}, null.TimeFrom(utc(1, 10))),
+			"(,2024-01-01T10:00:00Z)",
+		},
+		{
+			null.TimeRange{
+				Lower:    null.TimeFrom(utc(1, 10).Add(time.Millisecond)),
+				UpperInc: true,
+				Valid:    true,
+			},
+			"(2024-01-01T10:00:00.001Z,)",
+		},
+		{null.TimeRange{Empty: true, Valid: true}, "empty"},
+		{null.TimeRange{}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestTimeRange_MarshalText(t *testing.T) {
+	cases := []struct {
+		nullable null.TimeRange
+		bytes    []byte
+	}{
+		{tr("[2024-01-01T10:00:00Z,)"), []byte("[2024-01-01T10:00:00Z,)")},
+		{null.TimeRange{}, nil},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalText()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.bytes, b) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(b),
+			)
+		}
+	}
+}
+
+func TestTimeRange_MarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable null.TimeRange
+		json     []byte
+		errType  reflect.Type
+	}{
+		{
+			tr("[2024-01-01T10:00:00Z,)"),
+			[]byte(`{"lower":"2024-01-01T10:00:00Z","upper":null,` +
+				`"lower_inc":true,"upper_inc":false}`),
+			nilType,
+		},
+		{tr("empty"), []byte(`{"empty":true}`), nilType},
+		{null.TimeRange{}, []byte("null"), nilType},
+		{
+			null.TimeRangeFrom(
+				null.TimeFrom(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)),
+				null.Time{},
+			),
+			nil,
+			marshalErrType,
+		},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestTimeRange_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.TimeRange
+		value    interface{}
+	}{
+		{tr("(,2024-01-01T10:00:00Z]"), "(,2024-01-01T10:00:00Z]"},
+		{tr("empty"), "empty"},
+		{null.TimeRange{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestTimeRange_Set(t *testing.T) {
+	var r null.TimeRange
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		valid   bool
+		value   string
+		errType reflect.Type
+	}{
+		{
+			`["2024-01-01 10:00:00+00","2024-01-02 00:00:00+00")`,
+			true, "[2024-01-01T10:00:00Z,2024-01-02T00:00:00Z)", nilType,
+		},
+		{
+			`("2024-01-01 10:00:00.5+05:30",]`,
+			true, "(2024-01-01T10:00:00.5+05:30,)", nilType,
+		},
+		{
+			`["2024-01-01 10:00:00",infinity]`,
+			true, "[2024-01-01T10:00:00Z,)", nilType,
+		},
+		{"[-infinity,infinity]", true, "(,)", nilType},
+		{"empty", true, "empty", nilType},
+		{"", false, "", nilType},
+		{"[2024-01-01,)", false, "", parseErrType},
+		{"[2024-01-01T10:00:00Z,2024-01-02T00:00:00Z", false, "", parseErrType},
+		{"[now,)", false, "", parseErrType},
+	}
+
+	for n, c := range cases {
+		err := r.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != r.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, r.Valid,
+			)
+		}
+		if r.Valid && c.value != r.String() {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %s, got %s)",
+				t.Name(), n+1, c.value, r.String(),
+			)
+		}
+	}
+}
+
+func TestTimeRange_UnmarshalText(t *testing.T) {
+	var r null.TimeRange
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("[2024-01-01T10:00:00Z,)"), true, nilType},
+		{nil, false, nilType},
+		{[]byte("[yesterday,)"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := r.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != r.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, r.Valid,
+			)
+		}
+	}
+}
+
+func TestTimeRange_UnmarshalJSON(t *testing.T) {
+	var r null.TimeRange
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		value   string
+		errType reflect.Type
+	}{
+		{
+			[]byte(`{"lower":"2024-01-01T10:00:00Z","upper":null}`),
+			true, "[2024-01-01T10:00:00Z,)", nilType,
+		},
+		{
+			[]byte(`{"upper":"2024-01-01T10:00:00Z","upper_inc":true}`),
+			true, "(,2024-01-01T10:00:00Z]", nilType,
+		},
+		{[]byte(`{"empty":true}`), true, "empty", nilType},
+		{[]byte(`{}`), true, "(,)", nilType},
+		{[]byte("null"), false, "", nilType},
+		{[]byte(`{"lower":"2024-01-01"}`), false, "", unmarshalErrType},
+		{[]byte(`{"lower":1}`), false, "", unmarshalErrType},
+		{[]byte("[]"), false, "", unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := r.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != r.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, r.Valid,
+			)
+		}
+		if r.Valid && c.value != r.String() {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %s, got %s)",
+				t.Name(), n+1, c.value, r.String(),
+			)
+		}
+	}
+}
+
+func TestTimeRange_Scan(t *testing.T) {
+	var r null.TimeRange
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{`["2024-01-01 10:00:00+00",)`, true, nilType},
+		{[]byte("empty"), true, nilType},
+		{nil, false, nilType},
+		{"", false, parseErrType},
+		{"[,", false, parseErrType},
+		{utc(1, 0), false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := r.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != r.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, r.Valid,
+			)
+		}
+	}
+}
@@ -82,6 +82,44 @@ func (u *Uint) FromZero(v uint) {
 	u.Uint = v
 }
 
+// IsValid returns true if u is valid.
+func (u Uint) IsValid() bool {
+	return u.Valid
+}
+
+// Invalidate makes u invalid.
+func (u *Uint) Invalidate() {
+	u.Valid = false
+}
+
+// Interface returns the underlying value of u as uint if u is valid,
+// otherwise nil.
+func (u Uint) Interface() interface{} {
+	if u.Valid {
+		return u.Uint
+	}
+	return nil
+}
+
+// SetInterface invalidates u if v is nil, otherwise if v's type is uint,
+// it sets the underlying value of u to v, and u becomes valid.
+// If v's type is any other type, u becomes invalid, and a TypeError is
+// returned.
+func (u *Uint) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case uint:
+		u.Uint = value
+		u.Valid = true
+		return nil
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("set", value, "uint", "nil")
+	}
+}
+
 // String returns a string representation of u.
 // If u is valid, it returns a string representation of the underlying value
 // of u, otherwise it returns InvalidNullableString.
@@ -0,0 +1,706 @@
+package null_test
+
+import (
+	"null"
+	"reflect"
+	"testing"
+)
+
+func uint16p(v uint16) *uint16 {
+	return &v
+}
+
+func TestUint16From(t *testing.T) {
+	cases := []struct {
+		literal uint16
+		valid   bool
+	}{
+		{0, true},
+		{1, true},
+	}
+
+	for n, c := range cases {
+		u := null.Uint16From(c.literal)
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if !u.Valid {
+			continue
+		}
+
+		if c.literal != u.Uint16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, u.Uint16,
+			)
+		}
+	}
+}
+
+func TestUint16FromPtr(t *testing.T) {
+	cases := []struct {
+		ptr   *uint16
+		valid bool
+	}{
+		{uint16p(0), true},
+		{uint16p(1), true},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		u := null.Uint16FromPtr(c.ptr)
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if !u.Valid {
+			continue
+		}
+
+		if *c.ptr != u.Uint16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, *c.ptr, u.Uint16,
+			)
+		}
+	}
+}
+
+func TestUint16FromZero(t *testing.T) {
+	cases := []struct {
+		literal uint16
+		valid   bool
+	}{
+		{0, false},
+		{1, true},
+	}
+
+	for n, c := range cases {
+		u := null.Uint16FromZero(c.literal)
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if !u.Valid {
+			continue
+		}
+
+		if c.literal != u.Uint16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, u.Uint16,
+			)
+		}
+	}
+}
+
+func TestUint16_Ptr(t *testing.T) {
+	cases := []struct {
+		nullable null.Uint16
+	}{
+		{null.Uint16{Uint16: 0, Valid: true}},
+		{null.Uint16{Uint16: 1, Valid: true}},
+		{null.Uint16{}},
+		{null.Uint16{Uint16: 2, Valid: false}},
+	}
+
+	for n, c := range cases {
+		p := c.nullable.Ptr()
+		if c.nullable.Valid {
+			if p == nil {
+				t.Fatalf(
+					"%s, case #%d: nil pointer returned",
+					t.Name(), n+1,
+				)
+			}
+			if c.nullable.Uint16 != *p {
+				t.Fatalf(
+					"%s, case #%d: literal mismatch (expected %d, got %d)",
+					t.Name(), n+1, c.nullable.Uint16, *p,
+				)
+			}
+		} else {
+			if p != nil {
+				t.Fatalf(
+					"%s, case #%d: non-nil pointer returned",
+					t.Name(), n+1,
+				)
+			}
+		}
+	}
+}
+
+func TestUint16_Zero(t *testing.T) {
+	cases := []struct {
+		nullable null.Uint16
+	}{
+		{null.Uint16{Uint16: 0, Valid: true}},
+		{null.Uint16{Uint16: 1, Valid: true}},
+		{null.Uint16{}},
+		{null.Uint16{Uint16: 2, Valid: false}},
+	}
+
+	for n, c := range cases {
+		u := c.nullable.Zero()
+		if c.nullable.Valid {
+			if c.nullable.Uint16 != u {
+				t.Fatalf(
+					"%s, case #%d: literal mismatch (expected %d, got %d)",
+					t.Name(), n+1, c.nullable.Uint16, u,
+				)
+			}
+		} else {
+			if u != 0 {
+				t.Fatalf(
+					"%s, case #%d: non-zero uint16 returned",
+					t.Name(), n+1,
+				)
+			}
+		}
+	}
+}
+
+func TestUint16_From(t *testing.T) {
+	var u null.Uint16
+	cases := []struct {
+		literal uint16
+		valid   bool
+	}{
+		{0, true},
+		{1, true},
+	}
+
+	for n, c := range cases {
+		u.From(c.literal)
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if !u.Valid {
+			continue
+		}
+
+		if c.literal != u.Uint16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, u.Uint16,
+			)
+		}
+	}
+}
+
+func TestUint16_FromPtr(t *testing.T) {
+	var u null.Uint16
+	cases := []struct {
+		ptr   *uint16
+		valid bool
+	}{
+		{uint16p(0), true},
+		{uint16p(1), true},
+		{nil, false},
+	}
+
+	for n, c := range cases {
+		u.FromPtr(c.ptr)
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if !u.Valid {
+			continue
+		}
+
+		if *c.ptr != u.Uint16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, *c.ptr, u.Uint16,
+			)
+		}
+	}
+}
+
+func TestUint16_FromZero(t *testing.T) {
+	var u null.Uint16
+	cases := []struct {
+		literal uint16
+		valid   bool
+	}{
+		{0, false},
+		{1, true},
+	}
+
+	for n, c := range cases {
+		u.FromZero(c.literal)
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if !u.Valid {
+			continue
+		}
+
+		if c.literal != u.Uint16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, u.Uint16,
+			)
+		}
+	}
+}
+
+func TestUint16_String(t *testing.T) {
+	cases := []struct {
+		nullable null.Uint16
+		string   string
+	}{
+		{null.Uint16{Uint16: 0, Valid: true}, "0"},
+		{null.Uint16{Uint16: 1, Valid: true}, "1"},
+		{null.Uint16{}, "<invalid>"},
+		{null.Uint16{Uint16: 2, Valid: false}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestUint16_MarshalText(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+
+	cases := []struct {
+		nullable null.Uint16
+		bytes    []byte
+		errType  reflect.Type
+	}{
+		{null.Uint16{Uint16: 0, Valid: true}, []byte("0"), nilType},
+		{null.Uint16{Uint16: 1, Valid: true}, []byte("1"), nilType},
+		{null.Uint16{}, nil, nilType},
+		{null.Uint16{Uint16: 2, Valid: false}, nil, nilType},
+	}
+
+	for n, c := range cases {
+		u, err := c.nullable.MarshalText()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if !reflect.DeepEqual(c.bytes, u) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(u),
+			)
+		}
+	}
+}
+
+func TestUint16_MarshalJSON(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+
+	cases := []struct {
+		nullable null.Uint16
+		json     []byte
+		errType  reflect.Type
+	}{
+		{null.Uint16{Uint16: 0, Valid: true}, []byte("0"), nilType},
+		{null.Uint16{Uint16: 1, Valid: true}, []byte("1"), nilType},
+		{null.Uint16{}, []byte("null"), nilType},
+		{null.Uint16{Uint16: 2, Valid: false}, []byte("null"), nilType},
+	}
+
+	for n, c := range cases {
+		u, err := c.nullable.MarshalJSON()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if !reflect.DeepEqual(c.json, u) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(u),
+			)
+		}
+	}
+}
+
+func TestUint16_Value(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	i64Type := reflect.TypeOf(int64(0))
+
+	cases := []struct {
+		nullable null.Uint16
+		expType  reflect.Type
+		errType  reflect.Type
+	}{
+		{null.Uint16{Uint16: 0, Valid: true}, i64Type, nilType},
+		{null.Uint16{Uint16: 1, Valid: true}, i64Type, nilType},
+		{null.Uint16{}, nilType, nilType},
+		{null.Uint16{Uint16: 2, Valid: false}, nilType, nilType},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.expType != reflect.TypeOf(v) {
+			t.Fatalf(
+				"%s, case #%d: type mismatch (expected %s, got %s)",
+				t.Name(), n+1, c.expType, reflect.TypeOf(v),
+			)
+		}
+		if v == nil {
+			continue
+		}
+
+		if int64(c.nullable.Uint16) != reflect.ValueOf(v).Int() {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %v)",
+				t.Name(), n+1, c.nullable.Uint16, v,
+			)
+		}
+	}
+}
+
+func TestUint16_Set(t *testing.T) {
+	var u null.Uint16
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		literal uint16
+		valid   bool
+		errType reflect.Type
+	}{
+		{"0", 0, true, nilType},
+		{"1", 1, true, nilType},
+		{"010", 8, true, nilType},
+		{"0x10", 16, true, nilType},
+		{"", 0, false, nilType},
+		{"-1", 0, false, parseErrType},
+		{"0.1", 0, false, parseErrType},
+		{"x", 0, false, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := u.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if !u.Valid {
+			continue
+		}
+
+		if c.literal != u.Uint16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, u.Uint16,
+			)
+		}
+	}
+}
+
+func TestUint16_UnmarshalText(t *testing.T) {
+	var u null.Uint16
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		literal uint16
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("0"), 0, true, nilType},
+		{[]byte("1"), 1, true, nilType},
+		{[]byte("010"), 8, true, nilType},
+		{[]byte("0x10"), 16, true, nilType},
+		{nil, 0, false, nilType},
+		{[]byte(""), 0, false, nilType},
+		{[]byte("-1"), 0, false, unmarshalErrType},
+		{[]byte("0.1"), 0, false, unmarshalErrType},
+		{[]byte("x"), 0, false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := u.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if !u.Valid {
+			continue
+		}
+
+		if c.literal != u.Uint16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, u.Uint16,
+			)
+		}
+	}
+}
+
+func TestUint16_UnmarshalJSON(t *testing.T) {
+	var u null.Uint16
+	nilType := reflect.TypeOf(nil)
+	cnvErrType := reflect.TypeOf(null.ConversionError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		literal uint16
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("0"), 0, true, nilType},
+		{[]byte("1"), 1, true, nilType},
+		{[]byte("null"), 0, false, nilType},
+		{[]byte("0.1"), 0, false, cnvErrType},
+		{[]byte("-1"), 0, false, cnvErrType},
+		{[]byte(`"x"`), 0, false, typeErrType},
+		{nil, 0, false, unmarshalErrType},
+		{[]byte("x"), 0, false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := u.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if !u.Valid {
+			continue
+		}
+
+		if c.literal != u.Uint16 {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.literal, u.Uint16,
+			)
+		}
+	}
+}
+
+func TestUint16_Scan(t *testing.T) {
+	var u null.Uint16
+	nilType := reflect.TypeOf(nil)
+	conversionErrType := reflect.TypeOf(null.ConversionError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{int64(0), true, nilType},
+		{int64(1), true, nilType},
+		{nil, false, nilType},
+		{int64(-1), false, conversionErrType},
+		{float64(0.1), false, typeErrType},
+		{"x", false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := u.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if err != nil {
+			continue
+		}
+
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if !u.Valid {
+			continue
+		}
+
+		if c.source.(int64) != int64(u.Uint16) {
+			t.Fatalf(
+				"%s, case #%d: literal mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.source, u.Uint16,
+			)
+		}
+	}
+}
+
+func TestUint16_Range(t *testing.T) {
+	var u null.Uint16
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	cnvErrType := reflect.TypeOf(null.ConversionError{})
+
+	sets := []struct {
+		string  string
+		valid   bool
+		errType reflect.Type
+	}{
+		{"65535", true, nilType},
+		{"0", true, nilType},
+		{"65536", false, parseErrType},
+		{"-1", false, parseErrType},
+	}
+
+	for n, c := range sets {
+		err := u.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, set #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, set #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if c.valid && c.string != u.String() {
+			t.Fatalf(
+				"%s, set #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, u.String(),
+			)
+		}
+	}
+
+	jsons := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("65535"), true, nilType},
+		{[]byte("0"), true, nilType},
+		{[]byte("65536"), false, cnvErrType},
+		{[]byte("-1"), false, cnvErrType},
+	}
+
+	for n, c := range jsons {
+		err := u.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, json #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, json #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if c.valid && string(c.json) != u.String() {
+			t.Fatalf(
+				"%s, json #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), u.String(),
+			)
+		}
+	}
+
+	scans := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{int64(65536), false, cnvErrType},
+		{int64(-1), false, cnvErrType},
+	}
+
+	for n, c := range scans {
+		err := u.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, scan #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, scan #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+)
+
+// Uint64 implements a nullable uint64.
+type Uint64 struct {
+	// Uint64 holds the underlying uint64 value.
+	Uint64 uint64
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// Uint64From creates a valid Uint64 from v.
+func Uint64From(v uint64) Uint64 {
+	return Uint64FromPtr(&v)
+}
+
+// Uint64FromPtr creates an Uint64 from pointer p. If p is nil,
+// the returned Uint64 is invalid.
+func Uint64FromPtr(p *uint64) Uint64 {
+	if p != nil {
+		return Uint64{
+			Uint64: *p,
+			Valid:  true,
+		}
+	}
+	return Uint64{}
+}
+
+// Uint64FromZero creates an Uint64 from v. If v is 0,
+// the returned Uint64 is invalid.
+func Uint64FromZero(v uint64) Uint64 {
+	return Uint64{
+		Uint64: v,
+		Valid:  v != 0,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of u if u is valid,
+// otherwise returns nil.
+func (u Uint64) Ptr() *uint64 {
+	if u.Valid {
+		return &u.Uint64
+	}
+	return nil
+}
+
+// Zero returns the underlying value of u if u is valid, otherwise
+// returns 0.
+func (u Uint64) Zero() uint64 {
+	if u.Valid {
+		return u.Uint64
+	}
+	return 0
+}
+
+// From sets the underlying value of u to v. u becomes valid.
+func (u *Uint64) From(v uint64) {
+	u.Valid = true
+	u.Uint64 = v
+}
+
+// FromPtr invalidates u if p is nil, otherwise it sets the underlying value
+// of u to the value pointed to by p, and u becomes valid.
+func (u *Uint64) FromPtr(p *uint64) {
+	u.Valid = p != nil
+	if p != nil {
+		u.Uint64 = *p
+	}
+}
+
+// FromZero invalidates u if v is 0,
+// otherwise it sets the underlying value of u to v, and u becomes valid.
+func (u *Uint64) FromZero(v uint64) {
+	u.Valid = v != 0
+	u.Uint64 = v
+}
+
+// IsValid returns true if u is valid.
+func (u Uint64) IsValid() bool {
+	return u.Valid
+}
+
+// Invalidate makes u invalid.
+func (u *Uint64) Invalidate() {
+	u.Valid = false
+}
+
+// Interface returns the underlying value of u as uint64 if u is valid,
+// otherwise nil.
+func (u Uint64) Interface() interface{} {
+	if u.Valid {
+		return u.Uint64
+	}
+	return nil
+}
+
+// SetInterface invalidates u if v is nil, otherwise if v's type is uint64,
+// it sets the underlying value of u to v, and u becomes valid.
+// If v's type is any other type, u becomes invalid, and a TypeError is
+// returned.
+func (u *Uint64) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case uint64:
+		u.Uint64 = value
+		u.Valid = true
+		return nil
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("set", value, "uint64", "nil")
+	}
+}
+
+// String returns a string representation of u.
+// If u is valid, it returns a string representation of the underlying value
+// of u, otherwise it returns InvalidNullableString.
+func (u Uint64) String() string {
+	if u.Valid {
+		return strconv.FormatUint(u.Uint64, 10)
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals u to a byte string representation.
+// If u is valid, it marshals the underlying value of u to a byte string
+// representation, otherwise it returns nil. err is always nil.
+func (u Uint64) MarshalText() (data []byte, err error) {
+	if u.Valid {
+		return []byte(u.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of u to a JSON number if u is
+// valid, otherwise it returns the JSON null value. err is always nil.
+func (u Uint64) MarshalJSON() (data []byte, err error) {
+	if u.Valid {
+		return []byte(u.String()), nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of u converted to int64 if u is valid,
+// otherwise nil. If the conversion would cause data loss,
+// a ConversionError is returned.
+func (u Uint64) Value() (v driver.Value, err error) {
+	if u.Valid {
+		val := int64(u.Uint64)
+		if val < 0 {
+			return nil, makeConversionError("sql", u.Uint64, val)
+		}
+		return val, nil
+	}
+	return nil, nil
+}
+
+// Set invalidates u if str is the empty string, otherwise it parses str into
+// the underlying value of u, and u becomes valid. If str is not a valid
+// string representation of an unsigned integer, or if the represented integer
+// is too large to be stored in an uint64, u becomes invalid and a ParseError
+// is returned.
+func (u *Uint64) Set(str string) error {
+	var err error
+	u.Uint64, err = strconv.ParseUint(str, 0, 64)
+	u.Valid = err == nil && str != ""
+	if str == "" || err == nil {
+		return nil
+	}
+
+	return makeParseError("parse", str, u.Uint64)
+}
+
+// UnmarshalText unmarshals from a byte string to u.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (u *Uint64) UnmarshalText(text []byte) error {
+	if u.Set(string(text)) != nil {
+		return makeUnmarshalError("parse", text, *u)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to u.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, u becomes invalid. If the encoded JSON data
+// represent a JSON number, and can be stored in an uint64 without data
+// loss, u becomes valid, and the underlying value of u is set to the JSON
+// number. Unlike Uint, the JSON number is not converted to float64 first,
+// so that integers beyond 2^53 are not rounded. If the encoded JSON data
+// represent a JSON number, and cannot be stored in an uint64 without data
+// loss, u becomes invalid, and a ConversionError is returned. Other JSON
+// types produce a TypeError. Malformed JSON produces an UnmarshalError.
+func (u *Uint64) UnmarshalJSON(data []byte) error {
+	obj, ok := decodeJSONNumber(data)
+	if !ok {
+		u.Valid = false
+		return makeUnmarshalError("json", data, *u)
+	}
+	switch value := obj.(type) {
+	case json.Number:
+		var err error
+		u.Uint64, err = strconv.ParseUint(string(value), 10, 64)
+		if errors.Is(err, strconv.ErrRange) {
+			err = makeConversionError("json", value, u.Uint64)
+		} else if err != nil {
+			// accept integral numbers in other notations, such as 1e3
+			f, _ := value.Float64()
+			u.Uint64 = uint64(f)
+			err = nil
+			if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 {
+				err = makeConversionError("json", value, u.Uint64)
+			}
+		}
+		u.Valid = err == nil
+		return err
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("json", value, "json.Number", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is int64,
+// if the number can be stored in an uint64 without data loss,
+// u becomes valid, and the underlying value of u becomes the value of obj.
+// If obj's type is int64, and the number cannot be stored in an uint64 without
+// data loss, u becomes invalid, and a ConversionError is returned.
+// If obj is nil, u becomes invalid. If obj's type is any other type,
+// u becomes invalid, and a TypeError is returned.
+func (u *Uint64) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case int64:
+		u.Uint64 = uint64(value)
+		u.Valid = value == int64(u.Uint64) && value >= 0
+		if !u.Valid {
+			return makeConversionError("sql", value, u.Uint64)
+		}
+		return nil
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("sql", value, "int64", "nil")
+	}
+}
@@ -0,0 +1,242 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strconv"
+)
+
+// Uint8 implements a nullable uint8.
+type Uint8 struct {
+	// Uint8 holds the underlying uint8 value.
+	Uint8 uint8
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// Uint8From creates a valid Uint8 from v.
+func Uint8From(v uint8) Uint8 {
+	return Uint8FromPtr(&v)
+}
+
+// Uint8FromPtr creates an Uint8 from pointer p. If p is nil,
+// the returned Uint8 is invalid.
+func Uint8FromPtr(p *uint8) Uint8 {
+	if p != nil {
+		return Uint8{
+			Uint8: *p,
+			Valid: true,
+		}
+	}
+	return Uint8{}
+}
+
+// Uint8FromZero creates an Uint8 from v. If v is 0,
+// the returned Uint8 is invalid.
+func Uint8FromZero(v uint8) Uint8 {
+	return Uint8{
+		Uint8: v,
+		Valid: v != 0,
+	}
+}
+
+// Ptr returns a pointer to the underlying value of u if u is valid,
+// otherwise returns nil.
+func (u Uint8) Ptr() *uint8 {
+	if u.Valid {
+		return &u.Uint8
+	}
+	return nil
+}
+
+// Zero returns the underlying value of u if u is valid, otherwise
+// returns 0.
+func (u Uint8) Zero() uint8 {
+	if u.Valid {
+		return u.Uint8
+	}
+	return 0
+}
+
+// From sets the underlying value of u to v. u becomes valid.
+func (u *Uint8) From(v uint8) {
+	u.Valid = true
+	u.Uint8 = v
+}
+
+// FromPtr invalidates u if p is nil, otherwise it sets the underlying value
+// of u to the value pointed to by p, and u becomes valid.
+func (u *Uint8) FromPtr(p *uint8) {
+	u.Valid = p != nil
+	if p != nil {
+		u.Uint8 = *p
+	}
+}
+
+// FromZero invalidates u if v is 0,
+// otherwise it sets the underlying value of u to v, and u becomes valid.
+func (u *Uint8) FromZero(v uint8) {
+	u.Valid = v != 0
+	u.Uint8 = v
+}
+
+// IsValid returns true if u is valid.
+func (u Uint8) IsValid() bool {
+	return u.Valid
+}
+
+// Invalidate makes u invalid.
+func (u *Uint8) Invalidate() {
+	u.Valid = false
+}
+
+// Interface returns the underlying value of u as uint8 if u is valid,
+// otherwise nil.
+func (u Uint8) Interface() interface{} {
+	if u.Valid {
+		return u.Uint8
+	}
+	return nil
+}
+
+// SetInterface invalidates u if v is nil, otherwise if v's type is uint8,
+// it sets the underlying value of u to v, and u becomes valid.
+// If v's type is any other type, u becomes invalid, and a TypeError is
+// returned.
+func (u *Uint8) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case uint8:
+		u.Uint8 = value
+		u.Valid = true
+		return nil
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("set", value, "uint8", "nil")
+	}
+}
+
+// String returns a string representation of u.
+// If u is valid, it returns a string representation of the underlying value
+// of u, otherwise it returns InvalidNullableString.
+func (u Uint8) String() string {
+	if u.Valid {
+		return strconv.FormatUint(uint64(u.Uint8), 10)
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals u to a byte string representation.
+// If u is valid, it marshals the underlying value of u to a byte string
+// representation, otherwise it returns nil. err is always nil.
+func (u Uint8) MarshalText() (data []byte, err error) {
+	if u.Valid {
+		return []byte(u.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of u to a JSON number if u is
+// valid, otherwise it returns the JSON null value. err is always nil.
+func (u Uint8) MarshalJSON() (data []byte, err error) {
+	if u.Valid {
+		return []byte(u.String()), nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of u converted to int64 if u is valid,
+// otherwise nil. err is always nil.
+func (u Uint8) Value() (v driver.Value, err error) {
+	if u.Valid {
+		return int64(u.Uint8), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates u if str is the empty string, otherwise it parses str into
+// the underlying value of u, and u becomes valid. If str is not a valid
+// string representation of an unsigned integer, or if the represented integer
+// is too large to be stored in an uint8, u becomes invalid and a ParseError
+// is returned.
+func (u *Uint8) Set(str string) error {
+	val, err := strconv.ParseUint(str, 0, 8)
+	u.Uint8 = uint8(val)
+	u.Valid = err == nil && str != ""
+	if str == "" || err == nil {
+		return nil
+	}
+
+	return makeParseError("parse", str, u.Uint8)
+}
+
+// UnmarshalText unmarshals from a byte string to u.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (u *Uint8) UnmarshalText(text []byte) error {
+	if u.Set(string(text)) != nil {
+		return makeUnmarshalError("parse", text, *u)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to u.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, u becomes invalid. If the encoded JSON data
+// represent a JSON number, and can be stored in an uint8 without data
+// loss, u becomes valid, and the underlying value of u is set to the JSON
+// number. If the encoded JSON data represent a JSON number,
+// and cannot be stored in an uint8 without data loss, u becomes invalid,
+// and a ConversionError is returned. Other JSON types
+// produce a TypeError. Malformed JSON produces an UnmarshalError.
+func (u *Uint8) UnmarshalJSON(data []byte) error {
+	var obj interface{}
+	if json.Unmarshal(data, &obj) != nil {
+		u.Valid = false
+		return makeUnmarshalError("json", data, *u)
+	}
+	switch value := obj.(type) {
+	case float64:
+		u.Uint8 = uint8(value)
+		u.Valid = value == float64(u.Uint8) && value >= 0
+		if !u.Valid {
+			return makeConversionError("json", value, u.Uint8)
+		}
+		return nil
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("json", value, "float64", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is int64,
+// if the number can be stored in an uint8 without data loss,
+// u becomes valid, and the underlying value of u becomes the value of obj.
+// If obj's type is int64, and the number cannot be stored in an uint8 without
+// data loss, u becomes invalid, and a ConversionError is returned.
+// If obj is nil, u becomes invalid. If obj's type is any other type,
+// u becomes invalid, and a TypeError is returned.
+func (u *Uint8) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case int64:
+		u.Uint8 = uint8(value)
+		u.Valid = value == int64(u.Uint8) && value >= 0
+		if !u.Valid {
+			return makeConversionError("sql", value, u.Uint8)
+		}
+		return nil
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("sql", value, "int64", "nil")
+	}
+}
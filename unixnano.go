@@ -0,0 +1,273 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// UnixNano implements a nullable time.Time, which is represented as the
+// count of nanoseconds elapsed since the Unix epoch in JSON, text and SQL.
+type UnixNano struct {
+	// Time holds the underlying time.Time value.
+	Time time.Time
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// UnixNanoFrom creates a valid UnixNano from v.
+func UnixNanoFrom(v time.Time) UnixNano {
+	return UnixNanoFromPtr(&v)
+}
+
+// UnixNanoFromPtr creates a UnixNano from pointer p. If p is nil,
+// the returned UnixNano is invalid.
+func UnixNanoFromPtr(p *time.Time) UnixNano {
+	if p != nil {
+		return UnixNano{
+			Time:  *p,
+			Valid: true,
+		}
+	}
+	return UnixNano{}
+}
+
+// UnixNanoFromZero creates a UnixNano from v. If v represents the zero time
+// instant, the returned UnixNano is invalid. Note that the Unix epoch is not
+// the zero time instant.
+func UnixNanoFromZero(v time.Time) UnixNano {
+	return UnixNano{
+		Time:  v,
+		Valid: !v.IsZero(),
+	}
+}
+
+// Ptr returns a pointer to the underlying value of u if u is valid,
+// otherwise returns nil.
+func (u UnixNano) Ptr() *time.Time {
+	if u.Valid {
+		return &u.Time
+	}
+	return nil
+}
+
+// Zero returns the underlying value of u if u is valid, otherwise
+// returns a time.Time representing the zero time instant.
+func (u UnixNano) Zero() time.Time {
+	if u.Valid {
+		return u.Time
+	}
+	return time.Time{}
+}
+
+// From sets the underlying value of u to v. u becomes valid.
+func (u *UnixNano) From(v time.Time) {
+	u.Time = v
+	u.Valid = true
+}
+
+// FromPtr invalidates u if p is nil, otherwise it sets the underlying value
+// of u to the value pointed to by p, and u becomes valid.
+func (u *UnixNano) FromPtr(p *time.Time) {
+	u.Valid = p != nil
+	if p != nil {
+		u.Time = *p
+	}
+}
+
+// FromZero invalidates u if v represents the zero time instant,
+// otherwise it sets the underlying value of u to v, and u becomes valid.
+func (u *UnixNano) FromZero(v time.Time) {
+	u.Time = v
+	u.Valid = !v.IsZero()
+}
+
+// IsValid returns true if u is valid.
+func (u UnixNano) IsValid() bool {
+	return u.Valid
+}
+
+// Invalidate makes u invalid.
+func (u *UnixNano) Invalidate() {
+	u.Valid = false
+}
+
+// Interface returns the underlying value of u as time.Time if u is valid,
+// otherwise nil.
+func (u UnixNano) Interface() interface{} {
+	if u.Valid {
+		return u.Time
+	}
+	return nil
+}
+
+// SetInterface invalidates u if v is nil, otherwise if v's type is
+// time.Time, it sets the underlying value of u to v, and u becomes valid.
+// If v's type is any other type, u becomes invalid, and a TypeError is
+// returned.
+func (u *UnixNano) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case time.Time:
+		u.From(value)
+		return nil
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("set", value, "time.Time", "nil")
+	}
+}
+
+// ToTime converts u to a Time with the same underlying value and validity.
+func (u UnixNano) ToTime() Time {
+	return Time{
+		Time:  u.Time,
+		Valid: u.Valid,
+	}
+}
+
+// String returns a string representation of u. If u is valid,
+// it returns the count of nanoseconds elapsed between the Unix epoch and the
+// underlying value of u, in base 10, otherwise it returns
+// InvalidNullableString. If the count does not fit in an int64, it returns
+// the RFC3339 representation of the underlying value of u instead.
+func (u UnixNano) String() string {
+	if !u.Valid {
+		return InvalidNullableString
+	}
+	if n, ok := epoch(u.Time, time.Nanosecond); ok {
+		return strconv.FormatInt(n, 10)
+	}
+	return u.Time.Format(time.RFC3339Nano)
+}
+
+// MarshalText marshals u to a byte string representation. If u is valid,
+// it formats the count of nanoseconds elapsed between the Unix epoch and the
+// underlying value of u in base 10, otherwise it returns nil. If the count
+// does not fit in an int64, a MarshalError is returned.
+func (u UnixNano) MarshalText() (data []byte, err error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	n, ok := epoch(u.Time, time.Nanosecond)
+	if !ok {
+		return nil, makeMarshalError("text", u)
+	}
+	return []byte(strconv.FormatInt(n, 10)), nil
+}
+
+// MarshalJSON encodes the count of nanoseconds elapsed between the Unix epoch
+// and the underlying value of u to a JSON number if u is valid, otherwise it
+// returns the JSON null value. If the count does not fit in an int64, a
+// MarshalError is returned.
+func (u UnixNano) MarshalJSON() (data []byte, err error) {
+	if !u.Valid {
+		return jNull, nil
+	}
+	n, ok := epoch(u.Time, time.Nanosecond)
+	if !ok {
+		return nil, makeMarshalError("json", u)
+	}
+	return []byte(strconv.FormatInt(n, 10)), nil
+}
+
+// Value returns the count of nanoseconds elapsed between the Unix epoch and the
+// underlying value of u as an int64 if u is valid, otherwise nil. If the
+// count does not fit in an int64, a MarshalError is returned.
+func (u UnixNano) Value() (v driver.Value, err error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	n, ok := epoch(u.Time, time.Nanosecond)
+	if !ok {
+		return nil, makeMarshalError("sql", u)
+	}
+	return n, nil
+}
+
+// Set invalidates u if str is the empty string, otherwise it parses str as
+// a count of nanoseconds elapsed since the Unix epoch into the underlying value
+// of u, which is set in UTC, and u becomes valid. str may hold a fraction or
+// an exponent. If str cannot be parsed, u becomes invalid and a ParseError
+// is returned.
+func (u *UnixNano) Set(str string) error {
+	if str == "" {
+		u.Valid = false
+		return nil
+	}
+
+	var ok bool
+	u.Time, ok = parseEpoch(str, time.Nanosecond)
+	u.Valid = ok
+	if !ok {
+		return makeParseError("parse", str, *u)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to u.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (u *UnixNano) UnmarshalText(text []byte) error {
+	if u.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *u)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to u. If the encoded
+// JSON data represent the JSON null value, or an error is produced, u becomes
+// invalid. If the encoded JSON data represent a JSON number, u becomes valid,
+// and the underlying value of u is set to the time instant which is the JSON
+// number of nanoseconds after the Unix epoch, in UTC. If the JSON number does
+// not fit in an int64, u becomes invalid, and a ConversionError is returned.
+// Other JSON types produce a TypeError. Malformed JSON produces an
+// UnmarshalError.
+func (u *UnixNano) UnmarshalJSON(data []byte) error {
+	obj, ok := decodeJSONNumber(data)
+	if !ok {
+		u.Valid = false
+		return makeUnmarshalError("json", data, *u)
+	}
+	switch value := obj.(type) {
+	case json.Number:
+		u.Time, u.Valid = parseEpoch(string(value), time.Nanosecond)
+		if !u.Valid {
+			return makeConversionError("json", value, *u)
+		}
+		return nil
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("json", value, "json.Number", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is int64,
+// u becomes valid, and the underlying value of u becomes the time instant
+// which is obj nanoseconds after the Unix epoch, in UTC. If obj's type is
+// time.Time, u becomes valid, and the underlying value of u becomes the
+// value of obj. If obj is nil, u becomes invalid. If obj's type is any other
+// type, u becomes invalid, and a TypeError is returned.
+func (u *UnixNano) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case int64:
+		u.From(epochTime(value, time.Nanosecond))
+		return nil
+	case time.Time:
+		u.From(value)
+		return nil
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("sql", value, "int64", "time.Time", "nil")
+	}
+}
@@ -0,0 +1,270 @@
+package null_test
+
+import (
+	"null"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUnixNano_ToTime(t *testing.T) {
+	instant := time.Unix(1500000000, 250000000)
+
+	cases := []null.Time{null.TimeFrom(instant), {}}
+
+	for n, c := range cases {
+		tm := c.UnixNano().ToTime()
+		if c.Valid != tm.Valid || !c.Time.Equal(tm.Time) {
+			t.Fatalf(
+				"%s, case #%d: time mismatch (expected %v, got %v)",
+				t.Name(), n+1, c, tm,
+			)
+		}
+	}
+}
+
+func TestUnixNano_String(t *testing.T) {
+	instant := time.Unix(1500000000, 250000000)
+
+	cases := []struct {
+		nullable null.UnixNano
+		string   string
+	}{
+		{null.UnixNanoFrom(instant), "1500000000250000000"},
+		{null.UnixNanoFrom(time.Unix(0, 0)), "0"},
+		{null.UnixNano{}, "<invalid>"},
+		{null.UnixNano{Time: instant}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestUnixNano_MarshalJSON(t *testing.T) {
+	instant := time.Unix(1500000000, 250000000)
+	nilType := reflect.TypeOf(nil)
+	marshalErrType := reflect.TypeOf(null.MarshalError{})
+
+	cases := []struct {
+		nullable null.UnixNano
+		json     []byte
+		errType  reflect.Type
+	}{
+		{null.UnixNanoFrom(instant), []byte("1500000000250000000"), nilType},
+		{null.UnixNano{}, []byte("null"), nilType},
+		{
+			null.UnixNanoFrom(time.Date(2300, 1, 1, 0, 0, 0, 0, time.UTC)),
+			nil,
+			marshalErrType,
+		},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestUnixNano_Value(t *testing.T) {
+	instant := time.Unix(1500000000, 250000000)
+
+	cases := []struct {
+		nullable null.UnixNano
+		value    interface{}
+	}{
+		{null.UnixNanoFrom(instant), int64(1500000000250000000)},
+		{null.UnixNano{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestUnixNano_Set(t *testing.T) {
+	var u null.UnixNano
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		time    time.Time
+		valid   bool
+		errType reflect.Type
+	}{
+		{
+			"1500000000250000000",
+			time.Unix(1500000000, 250000000).Truncate(time.Nanosecond),
+			true,
+			nilType,
+		},
+		{"-1", time.Unix(0, 0).Add(-time.Nanosecond), true, nilType},
+		{"1e3", time.Unix(0, 0).Add(time.Microsecond), true, nilType},
+		{"", time.Time{}, false, nilType},
+		{"x", time.Time{}, false, parseErrType},
+		{"1e30", time.Time{}, false, parseErrType},
+		{"NaN", time.Time{}, false, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := u.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if c.valid && !c.time.Equal(u.Time) {
+			t.Fatalf(
+				"%s, case #%d: time mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.time, u.Time,
+			)
+		}
+	}
+}
+
+func TestUnixNano_UnmarshalText(t *testing.T) {
+	var u null.UnixNano
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("1500000000250000000"), true, nilType},
+		{nil, false, nilType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := u.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+	}
+}
+
+func TestUnixNano_UnmarshalJSON(t *testing.T) {
+	var u null.UnixNano
+	instant := time.Unix(1500000000, 250000000)
+	nilType := reflect.TypeOf(nil)
+	convErrType := reflect.TypeOf(null.ConversionError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte("1500000000250000000"), true, nilType},
+		{[]byte("null"), false, nilType},
+		{[]byte("1e30"), false, convErrType},
+		{[]byte(`"1500000000250000000"`), false, typeErrType},
+		{nil, false, unmarshalErrType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := u.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if u.Valid && !u.Time.Equal(instant.Truncate(time.Nanosecond)) {
+			t.Fatalf("%s, case #%d: wrong time %v", t.Name(), n+1, u.Time)
+		}
+	}
+}
+
+func TestUnixNano_Scan(t *testing.T) {
+	var u null.UnixNano
+	instant := time.Unix(1500000000, 250000000)
+	exp := instant.Truncate(time.Nanosecond)
+	nilType := reflect.TypeOf(nil)
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{int64(1500000000250000000), true, nilType},
+		{instant, true, nilType},
+		{nil, false, nilType},
+		{"1500000000250000000", false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := u.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if u.Valid && !u.Time.Truncate(time.Nanosecond).Equal(exp) {
+			t.Fatalf(
+				"%s, case #%d: time mismatch (expected %v, got %v)",
+				t.Name(), n+1, exp, u.Time,
+			)
+		}
+	}
+}
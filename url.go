@@ -0,0 +1,329 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// URLOption configures the validation performed by a URL when it parses a
+// string. See NewURL.
+type URLOption func(*urlRules)
+
+// urlRules holds the validation rules of a URL.
+type urlRules struct {
+	absolute bool
+	schemes  []string
+}
+
+// URLRequireAbsolute makes a URL reject URLs which are not absolute, that is,
+// which have no scheme.
+func URLRequireAbsolute() URLOption {
+	return func(r *urlRules) {
+		r.absolute = true
+	}
+}
+
+// URLAllowSchemes makes a URL reject URLs whose scheme is not one of
+// schemes, compared case-insensitively. It implies URLRequireAbsolute.
+func URLAllowSchemes(schemes ...string) URLOption {
+	return func(r *urlRules) {
+		r.absolute = true
+		r.schemes = append(r.schemes, schemes...)
+	}
+}
+
+// URL implements a nullable url.URL. URLs are validated when they are parsed
+// by Set, UnmarshalText, UnmarshalJSON and Scan. Besides the syntax checks of
+// url.Parse, further rules can be configured with NewURL.
+type URL struct {
+	// URL holds the underlying url.URL value.
+	URL url.URL
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+
+	rules *urlRules
+}
+
+// NewURL creates an invalid URL which enforces the rules configured by opts
+// whenever it parses a string, for instance:
+//
+//	webhook := null.NewURL(null.URLAllowSchemes("https"))
+//	err := webhook.Set("http://example.com") // ParseError
+//
+// The rules are preserved by copies of the URL, and by all the methods which
+// modify it.
+func NewURL(opts ...URLOption) URL {
+	rules := &urlRules{}
+	for _, opt := range opts {
+		opt(rules)
+	}
+	return URL{rules: rules}
+}
+
+// URLFrom creates a valid URL from v.
+func URLFrom(v url.URL) URL {
+	return URLFromPtr(&v)
+}
+
+// URLFromPtr creates a URL from pointer p. If p is nil,
+// the returned URL is invalid.
+func URLFromPtr(p *url.URL) URL {
+	if p != nil {
+		return URL{
+			URL:   *p,
+			Valid: true,
+		}
+	}
+	return URL{}
+}
+
+// URLFromZero creates a URL from v. If v is the zero url.URL,
+// the returned URL is invalid.
+func URLFromZero(v url.URL) URL {
+	return URL{
+		URL:   v,
+		Valid: v != url.URL{},
+	}
+}
+
+// Ptr returns a pointer to the underlying value of u if u is valid,
+// otherwise returns nil.
+func (u URL) Ptr() *url.URL {
+	if u.Valid {
+		return &u.URL
+	}
+	return nil
+}
+
+// Zero returns the underlying value of u if u is valid, otherwise returns
+// the zero url.URL.
+func (u URL) Zero() url.URL {
+	if u.Valid {
+		return u.URL
+	}
+	return url.URL{}
+}
+
+// From sets the underlying value of u to v. u becomes valid. v is not
+// validated against the rules of u.
+func (u *URL) From(v url.URL) {
+	u.URL = v
+	u.Valid = true
+}
+
+// FromPtr invalidates u if p is nil, otherwise it sets the underlying value
+// of u to the value pointed to by p, and u becomes valid.
+func (u *URL) FromPtr(p *url.URL) {
+	u.Valid = p != nil
+	if p != nil {
+		u.URL = *p
+	}
+}
+
+// FromZero invalidates u if v is the zero url.URL, otherwise it sets the
+// underlying value of u to v, and u becomes valid.
+func (u *URL) FromZero(v url.URL) {
+	u.URL = v
+	u.Valid = v != url.URL{}
+}
+
+// IsValid returns true if u is valid.
+func (u URL) IsValid() bool {
+	return u.Valid
+}
+
+// Invalidate makes u invalid.
+func (u *URL) Invalidate() {
+	u.Valid = false
+}
+
+// Interface returns the underlying value of u as url.URL if u is valid,
+// otherwise nil.
+func (u URL) Interface() interface{} {
+	if u.Valid {
+		return u.URL
+	}
+	return nil
+}
+
+// SetInterface invalidates u if v is nil, otherwise if v's type is url.URL,
+// it sets the underlying value of u to v, and u becomes valid.
+// If v's type is any other type, u becomes invalid, and a TypeError is
+// returned.
+func (u *URL) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case url.URL:
+		u.From(value)
+		return nil
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("set", value, "url.URL", "nil")
+	}
+}
+
+// String returns a string representation of u. If u is valid,
+// it formats the underlying value of u like url.URL.String does,
+// otherwise it returns InvalidNullableString.
+func (u URL) String() string {
+	if u.Valid {
+		return u.URL.String()
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals u to a byte string representation. If u is valid,
+// it formats the underlying value of u like url.URL.String does,
+// otherwise it returns nil. err is always nil.
+func (u URL) MarshalText() (data []byte, err error) {
+	if u.Valid {
+		return []byte(u.URL.String()), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of u to a JSON string formatted
+// like url.URL.String does if u is valid, otherwise it returns the JSON null
+// value. err is always nil.
+func (u URL) MarshalJSON() (data []byte, err error) {
+	if u.Valid {
+		bytes, err := json.Marshal(u.URL.String())
+		if err != nil {
+			// this should never happen
+			panic(err)
+		}
+		return bytes, nil
+	}
+	return jNull, nil
+}
+
+// Value returns the underlying value of u formatted like url.URL.String does
+// if u is valid, otherwise nil. err is always nil.
+func (u URL) Value() (v driver.Value, err error) {
+	if u.Valid {
+		return u.URL.String(), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates u if str is the empty string, otherwise it parses str into
+// the underlying value of u, and u becomes valid. If str cannot be parsed by
+// url.Parse, or it breaks the rules of u, u becomes invalid and a ParseError
+// is returned.
+func (u *URL) Set(str string) error {
+	if str == "" {
+		u.Valid = false
+		return nil
+	}
+
+	if !u.parse(str) {
+		return makeParseError("parse", str, *u)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to u.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (u *URL) UnmarshalText(text []byte) error {
+	if u.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *u)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to u.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, u becomes invalid. If the encoded JSON data
+// represent a JSON string which Set accepts, u becomes valid, and the
+// underlying value of u is set accordingly, otherwise a ParseError is
+// returned. Other JSON types produce a TypeError. Malformed JSON produces an
+// UnmarshalError.
+func (u *URL) UnmarshalJSON(data []byte) error {
+	var obj interface{}
+	if json.Unmarshal(data, &obj) != nil {
+		u.Valid = false
+		return makeUnmarshalError("json", data, *u)
+	}
+	switch value := obj.(type) {
+	case string:
+		if u.Set(value) != nil || value == "" {
+			u.Valid = false
+			return makeParseError("parse", value, *u)
+		}
+		return nil
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("json", value, "string", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is string or
+// []byte, it is parsed like Set does, except that the empty string produces
+// a ParseError. If obj is nil, u becomes invalid. If obj's type is any other
+// type, u becomes invalid, and a TypeError is returned.
+func (u *URL) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case string:
+		return u.scanString(value)
+	case []byte:
+		return u.scanString(string(value))
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("sql", value, "string", "[]byte", "nil")
+	}
+}
+
+// scanString parses a URL returned by a database driver as a string.
+// Unlike Set, the empty string is not accepted.
+func (u *URL) scanString(str string) error {
+	if str == "" || !u.parse(str) {
+		u.Valid = false
+		return makeParseError("sql", str, *u)
+	}
+	return nil
+}
+
+// parse sets the underlying value of u to str parsed by url.Parse, and
+// returns the validity of u, which is false if str cannot be parsed or it
+// breaks the rules of u.
+func (u *URL) parse(str string) bool {
+	v, err := url.Parse(str)
+	if err != nil {
+		u.URL, u.Valid = url.URL{}, false
+		return false
+	}
+	u.URL, u.Valid = *v, u.rules.allow(v)
+	return u.Valid
+}
+
+// allow returns true if v follows r. A nil r allows every URL.
+func (r *urlRules) allow(v *url.URL) bool {
+	if r == nil {
+		return true
+	}
+	if r.absolute && !v.IsAbs() {
+		return false
+	}
+	if len(r.schemes) == 0 {
+		return true
+	}
+	for _, scheme := range r.schemes {
+		if strings.EqualFold(scheme, v.Scheme) {
+			return true
+		}
+	}
+	return false
+}
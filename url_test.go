@@ -0,0 +1,328 @@
+package null_test
+
+import (
+	"net/url"
+	"null"
+	"reflect"
+	"testing"
+)
+
+// hook is a URL used throughout the tests, and hookString is its string
+// form.
+var hook = url.URL{Scheme: "https", Host: "example.com", Path: "/hook"}
+
+const hookString = "https://example.com/hook"
+
+func TestURLFromZero(t *testing.T) {
+	cases := []struct {
+		value url.URL
+		valid bool
+	}{
+		{hook, true},
+		{url.URL{Path: "a"}, true},
+		{url.URL{}, false},
+	}
+
+	for n, c := range cases {
+		u := null.URLFromZero(c.value)
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+	}
+}
+
+func TestURL_String(t *testing.T) {
+	cases := []struct {
+		nullable null.URL
+		string   string
+	}{
+		{null.URLFrom(hook), hookString},
+		{null.URLFrom(url.URL{Path: "a b"}), "a%20b"},
+		{null.URL{}, "<invalid>"},
+		{null.URL{URL: hook}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestURL_MarshalText(t *testing.T) {
+	cases := []struct {
+		nullable null.URL
+		bytes    []byte
+	}{
+		{null.URLFrom(hook), []byte(hookString)},
+		{null.URL{}, nil},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalText()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.bytes, b) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(b),
+			)
+		}
+	}
+}
+
+func TestURL_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		nullable null.URL
+		json     []byte
+	}{
+		{null.URLFrom(hook), []byte(`"` + hookString + `"`)},
+		{
+			null.URLFrom(url.URL{Path: "/", RawQuery: "a=1&b=2"}),
+			[]byte(`"/?a=1\u0026b=2"`),
+		},
+		{null.URL{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestURL_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.URL
+		value    interface{}
+	}{
+		{null.URLFrom(hook), hookString},
+		{null.URL{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestURL_Set(t *testing.T) {
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		nullable null.URL
+		string   string
+		valid    bool
+		errType  reflect.Type
+	}{
+		{null.URL{}, hookString, true, nilType},
+		{null.URL{}, "/relative/path?q=1", true, nilType},
+		{null.URL{}, "mailto:someone@example.com", true, nilType},
+		{null.URL{}, "", false, nilType},
+		{null.URL{}, "http://example.com/%zz", false, parseErrType},
+		{null.URL{}, "http://example.com:port", false, parseErrType},
+		{null.URL{}, "http://example.com/\x7f", false, parseErrType},
+		{null.URL{}, ":", false, parseErrType},
+		{null.NewURL(), "/relative", true, nilType},
+		{null.NewURL(null.URLRequireAbsolute()), hookString, true, nilType},
+		{null.NewURL(null.URLRequireAbsolute()), "/a", false, parseErrType},
+		{null.NewURL(null.URLRequireAbsolute()), "", false, nilType},
+		{null.NewURL(null.URLAllowSchemes("https")), hookString, true, nilType},
+		{null.NewURL(null.URLAllowSchemes("HTTPS")), hookString, true, nilType},
+		{
+			null.NewURL(null.URLAllowSchemes("http", "https")),
+			"HTTP://example.com",
+			true,
+			nilType,
+		},
+		{
+			null.NewURL(null.URLAllowSchemes("https")),
+			"http://example.com",
+			false,
+			parseErrType,
+		},
+		{
+			null.NewURL(null.URLAllowSchemes("https")),
+			"//example.com",
+			false,
+			parseErrType,
+		},
+	}
+
+	for n, c := range cases {
+		u := c.nullable
+		err := u.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if n == 0 && u.URL != hook {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, hook, u.URL,
+			)
+		}
+	}
+}
+
+func TestURL_Rules(t *testing.T) {
+	u := null.NewURL(null.URLAllowSchemes("https"))
+	u.From(url.URL{Scheme: "ftp", Host: "example.com"})
+	if !u.Valid {
+		t.Fatalf("%s: From must not validate its argument", t.Name())
+	}
+
+	v := u
+	v.Invalidate()
+	if v.Set("ftp://example.com") == nil || v.Valid {
+		t.Fatalf("%s: rules are not preserved by copies", t.Name())
+	}
+	if v.Set(hookString) != nil || !v.Valid {
+		t.Fatalf("%s: unexpected error", t.Name())
+	}
+}
+
+func TestURL_UnmarshalText(t *testing.T) {
+	var u null.URL
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(hookString), true, nilType},
+		{nil, false, nilType},
+		{[]byte("%zz"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := u.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+	}
+}
+
+func TestURL_UnmarshalJSON(t *testing.T) {
+	u := null.NewURL(null.URLRequireAbsolute())
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`"` + hookString + `"`), true, nilType},
+		{[]byte("null"), false, nilType},
+		{[]byte(`""`), false, parseErrType},
+		{[]byte(`"%zz"`), false, parseErrType},
+		{[]byte(`"/relative"`), false, parseErrType},
+		{[]byte("1"), false, typeErrType},
+		{nil, false, unmarshalErrType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := u.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+	}
+}
+
+func TestURL_Scan(t *testing.T) {
+	u := null.NewURL(null.URLAllowSchemes("https"))
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{hookString, true, nilType},
+		{[]byte(hookString), true, nilType},
+		{nil, false, nilType},
+		{"", false, parseErrType},
+		{"%zz", false, parseErrType},
+		{"http://example.com", false, parseErrType},
+		{int64(1), false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := u.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if u.Valid && u.URL != hook {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, hook, u.URL,
+			)
+		}
+	}
+}
@@ -0,0 +1,321 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// UUID implements a nullable universally unique identifier, as defined in
+// RFC 9562. Only UUIDs with the RFC 9562 variant and a version between 1 and
+// 8 are accepted when parsing, besides the nil UUID and the max UUID.
+type UUID struct {
+	// UUID holds the underlying UUID value.
+	UUID [16]byte
+
+	// Valid holds the validity flag. If true, the underlying value is valid.
+	// If false, it is invalid, and thus meaningless.
+	Valid bool
+}
+
+// UUIDFrom creates a valid UUID from v.
+func UUIDFrom(v [16]byte) UUID {
+	return UUIDFromPtr(&v)
+}
+
+// UUIDFromPtr creates a UUID from pointer p. If p is nil,
+// the returned UUID is invalid.
+func UUIDFromPtr(p *[16]byte) UUID {
+	if p != nil {
+		return UUID{
+			UUID:  *p,
+			Valid: true,
+		}
+	}
+	return UUID{}
+}
+
+// UUIDFromZero creates a UUID from v. If v is the nil UUID,
+// the returned UUID is invalid.
+func UUIDFromZero(v [16]byte) UUID {
+	return UUID{
+		UUID:  v,
+		Valid: v != [16]byte{},
+	}
+}
+
+// Ptr returns a pointer to the underlying value of u if u is valid,
+// otherwise returns nil.
+func (u UUID) Ptr() *[16]byte {
+	if u.Valid {
+		return &u.UUID
+	}
+	return nil
+}
+
+// Zero returns the underlying value of u if u is valid, otherwise returns the
+// nil UUID.
+func (u UUID) Zero() [16]byte {
+	if u.Valid {
+		return u.UUID
+	}
+	return [16]byte{}
+}
+
+// From sets the underlying value of u to v. u becomes valid.
+func (u *UUID) From(v [16]byte) {
+	u.UUID = v
+	u.Valid = true
+}
+
+// FromPtr invalidates u if p is nil, otherwise it sets the underlying value
+// of u to the value pointed to by p, and u becomes valid.
+func (u *UUID) FromPtr(p *[16]byte) {
+	u.Valid = p != nil
+	if p != nil {
+		u.UUID = *p
+	}
+}
+
+// FromZero invalidates u if v is the nil UUID, otherwise it sets the
+// underlying value of u to v, and u becomes valid.
+func (u *UUID) FromZero(v [16]byte) {
+	u.UUID = v
+	u.Valid = v != [16]byte{}
+}
+
+// IsValid returns true if u is valid.
+func (u UUID) IsValid() bool {
+	return u.Valid
+}
+
+// Invalidate makes u invalid.
+func (u *UUID) Invalidate() {
+	u.Valid = false
+}
+
+// Interface returns the underlying value of u as [16]byte if u is valid,
+// otherwise nil.
+func (u UUID) Interface() interface{} {
+	if u.Valid {
+		return u.UUID
+	}
+	return nil
+}
+
+// SetInterface invalidates u if v is nil, otherwise if v's type is [16]byte,
+// it sets the underlying value of u to v, and u becomes valid.
+// If v's type is any other type, u becomes invalid, and a TypeError is
+// returned.
+func (u *UUID) SetInterface(v interface{}) error {
+	switch value := v.(type) {
+	case [16]byte:
+		u.From(value)
+		return nil
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("set", value, "[16]byte", "nil")
+	}
+}
+
+// Version returns the version of the underlying value of u, which is 0 for
+// the nil UUID and 15 for the max UUID, if u is valid, otherwise it returns 0.
+func (u UUID) Version() int {
+	if u.Valid {
+		return int(u.UUID[6] >> 4)
+	}
+	return 0
+}
+
+// String returns a string representation of u. If u is valid,
+// it formats the underlying value of u in the canonical form
+// xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx, in lowercase, otherwise it returns
+// InvalidNullableString.
+func (u UUID) String() string {
+	if u.Valid {
+		return string(u.canonical())
+	}
+	return InvalidNullableString
+}
+
+// MarshalText marshals u to a byte string representation. If u is valid,
+// it formats the underlying value of u in the canonical form,
+// otherwise it returns nil. err is always nil.
+func (u UUID) MarshalText() (data []byte, err error) {
+	if u.Valid {
+		return u.canonical(), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON encodes the underlying value of u to a JSON string holding its
+// canonical form if u is valid, otherwise it returns the JSON null value.
+// err is always nil.
+func (u UUID) MarshalJSON() (data []byte, err error) {
+	if u.Valid {
+		return []byte(`"` + string(u.canonical()) + `"`), nil
+	}
+	return jNull, nil
+}
+
+// Value returns the canonical form of the underlying value of u as a string
+// if u is valid, otherwise nil. err is always nil.
+func (u UUID) Value() (v driver.Value, err error) {
+	if u.Valid {
+		return string(u.canonical()), nil
+	}
+	return nil, nil
+}
+
+// Set invalidates u if str is the empty string, otherwise it parses str into
+// the underlying value of u, and u becomes valid. str may be in the
+// canonical form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx, in the braced form
+// {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}, or in the URN form
+// urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx, in any case. If str cannot
+// be parsed, or if the parsed UUID has an unknown version or variant,
+// u becomes invalid and a ParseError is returned.
+func (u *UUID) Set(str string) error {
+	if str == "" {
+		u.Valid = false
+		return nil
+	}
+
+	u.UUID, u.Valid = parseUUID(str)
+	if !u.Valid {
+		return makeParseError("parse", str, *u)
+	}
+	return nil
+}
+
+// UnmarshalText unmarshals from a byte string to u.
+// It behaves like Set, except that it returns an UnmarshalError instead of a
+// ParseError in case text cannot be parsed.
+func (u *UUID) UnmarshalText(text []byte) error {
+	if u.Set(string(text)) != nil {
+		return makeUnmarshalError("text", text, *u)
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals from a JSON encoded byte string to u.
+// If the encoded JSON data represent the JSON null value,
+// or an error is produced, u becomes invalid. If the encoded JSON data
+// represent a JSON string which Set accepts, u becomes valid, and the
+// underlying value of u is set accordingly, otherwise a ParseError is
+// returned. Other JSON types produce a TypeError. Malformed JSON produces an
+// UnmarshalError.
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	var obj interface{}
+	if json.Unmarshal(data, &obj) != nil {
+		u.Valid = false
+		return makeUnmarshalError("json", data, *u)
+	}
+	switch value := obj.(type) {
+	case string:
+		if u.Set(value) != nil || value == "" {
+			u.Valid = false
+			return makeParseError("parse", value, *u)
+		}
+		return nil
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("json", value, "string", "nil")
+	}
+}
+
+// Scan assigns a value from a database driver. If obj's type is []byte of
+// length 16, it holds the binary form of the UUID, otherwise if obj's type is
+// string or []byte, it is parsed like Set does. u becomes valid if the UUID
+// has a known version and variant, otherwise u becomes invalid, and a
+// ParseError is returned. If obj is nil, u becomes invalid. If obj's type is
+// any other type, u becomes invalid, and a TypeError is returned.
+func (u *UUID) Scan(obj interface{}) error {
+	switch value := obj.(type) {
+	case []byte:
+		if len(value) == 16 {
+			copy(u.UUID[:], value)
+			u.Valid = validUUID(u.UUID)
+			if !u.Valid {
+				return makeParseError("sql", hex.EncodeToString(value), *u)
+			}
+			return nil
+		}
+		return u.scanString(string(value))
+	case string:
+		return u.scanString(value)
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		u.Valid = false
+		return makeTypeError("sql", value, "[]byte", "string", "nil")
+	}
+}
+
+// scanString parses a UUID returned by a database driver as a string.
+// Unlike Set, the empty string is not accepted.
+func (u *UUID) scanString(str string) error {
+	u.UUID, u.Valid = parseUUID(str)
+	if !u.Valid {
+		return makeParseError("sql", str, *u)
+	}
+	return nil
+}
+
+// canonical returns the underlying value of u in the canonical form,
+// regardless of its validity.
+func (u UUID) canonical() []byte {
+	buf := make([]byte, 36)
+	hex.Encode(buf[0:8], u.UUID[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u.UUID[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u.UUID[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u.UUID[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u.UUID[10:])
+	return buf
+}
+
+// parseUUID parses str in the canonical, braced or URN form. ok is false if
+// str cannot be parsed, or the parsed UUID is not valid according to
+// validUUID.
+func parseUUID(str string) (v [16]byte, ok bool) {
+	switch {
+	case len(str) == 38 && str[0] == '{' && str[37] == '}':
+		str = str[1:37]
+	case len(str) == 45 && strings.EqualFold(str[:9], "urn:uuid:"):
+		str = str[9:]
+	}
+	if len(str) != 36 ||
+		str[8] != '-' || str[13] != '-' || str[18] != '-' || str[23] != '-' {
+		return v, false
+	}
+
+	digits := str[0:8] + str[9:13] + str[14:18] + str[19:23] + str[24:]
+	if _, err := hex.Decode(v[:], []byte(digits)); err != nil {
+		return [16]byte{}, false
+	}
+	return v, validUUID(v)
+}
+
+// validUUID returns true if v is the nil UUID, the max UUID, or if it has the
+// RFC 9562 variant and a version between 1 and 8.
+func validUUID(v [16]byte) bool {
+	if v == [16]byte{} || v == [16]byte{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	} {
+		return true
+	}
+	version := v[6] >> 4
+	return version >= 1 && version <= 8 && v[8]&0xc0 == 0x80
+}
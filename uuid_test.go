@@ -0,0 +1,312 @@
+package null_test
+
+import (
+	"null"
+	"reflect"
+	"testing"
+)
+
+// v4 is a version 4 UUID used throughout the tests, and v4String is its
+// canonical form.
+var v4 = [16]byte{
+	0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x42, 0xd3,
+	0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00,
+}
+
+const v4String = "123e4567-e89b-42d3-a456-426614174000"
+
+func TestUUIDFromZero(t *testing.T) {
+	cases := []struct {
+		value [16]byte
+		valid bool
+	}{
+		{v4, true},
+		{[16]byte{}, false},
+	}
+
+	for n, c := range cases {
+		u := null.UUIDFromZero(c.value)
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+	}
+}
+
+func TestUUID_Version(t *testing.T) {
+	cases := []struct {
+		nullable null.UUID
+		version  int
+	}{
+		{null.UUIDFrom(v4), 4},
+		{null.UUIDFrom([16]byte{}), 0},
+		{null.UUID{UUID: v4}, 0},
+	}
+
+	for n, c := range cases {
+		if c.version != c.nullable.Version() {
+			t.Fatalf(
+				"%s, case #%d: version mismatch (expected %d, got %d)",
+				t.Name(), n+1, c.version, c.nullable.Version(),
+			)
+		}
+	}
+}
+
+func TestUUID_String(t *testing.T) {
+	cases := []struct {
+		nullable null.UUID
+		string   string
+	}{
+		{null.UUIDFrom(v4), v4String},
+		{null.UUIDFrom([16]byte{}), "00000000-0000-0000-0000-000000000000"},
+		{null.UUID{}, "<invalid>"},
+		{null.UUID{UUID: v4}, "<invalid>"},
+	}
+
+	for n, c := range cases {
+		if c.string != c.nullable.String() {
+			t.Fatalf(
+				"%s, case #%d: string mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, c.string, c.nullable.String(),
+			)
+		}
+	}
+}
+
+func TestUUID_MarshalText(t *testing.T) {
+	cases := []struct {
+		nullable null.UUID
+		bytes    []byte
+	}{
+		{null.UUIDFrom(v4), []byte(v4String)},
+		{null.UUID{}, nil},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalText()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.bytes, b) {
+			t.Fatalf(
+				"%s, case #%d: bytes mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.bytes), string(b),
+			)
+		}
+	}
+}
+
+func TestUUID_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		nullable null.UUID
+		json     []byte
+	}{
+		{null.UUIDFrom(v4), []byte(`"` + v4String + `"`)},
+		{null.UUID{}, []byte("null")},
+	}
+
+	for n, c := range cases {
+		b, err := c.nullable.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.json, b) {
+			t.Fatalf(
+				"%s, case #%d: json mismatch (expected '%s', got '%s')",
+				t.Name(), n+1, string(c.json), string(b),
+			)
+		}
+	}
+}
+
+func TestUUID_Value(t *testing.T) {
+	cases := []struct {
+		nullable null.UUID
+		value    interface{}
+	}{
+		{null.UUIDFrom(v4), v4String},
+		{null.UUID{}, nil},
+	}
+
+	for n, c := range cases {
+		v, err := c.nullable.Value()
+		if err != nil {
+			t.Fatalf("%s, case #%d: unexpected error %v", t.Name(), n+1, err)
+		}
+		if !reflect.DeepEqual(c.value, interface{}(v)) {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, c.value, v,
+			)
+		}
+	}
+}
+
+func TestUUID_Set(t *testing.T) {
+	var u null.UUID
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+
+	cases := []struct {
+		string  string
+		valid   bool
+		errType reflect.Type
+	}{
+		{v4String, true, nilType},
+		{"123E4567-E89B-42D3-A456-426614174000", true, nilType},
+		{"{" + v4String + "}", true, nilType},
+		{"urn:uuid:" + v4String, true, nilType},
+		{"URN:UUID:" + v4String, true, nilType},
+		{"00000000-0000-0000-0000-000000000000", true, nilType},
+		{"ffffffff-ffff-ffff-ffff-ffffffffffff", true, nilType},
+		{"", false, nilType},
+		{"123e4567e89b42d3a456426614174000", false, parseErrType},
+		{"{" + v4String, false, parseErrType},
+		{"123e4567-e89b-42d3-a456-42661417400g", false, parseErrType},
+		{"123e4567+e89b-42d3-a456-426614174000", false, parseErrType},
+		{"123e4567-e89b-02d3-a456-426614174000", false, parseErrType},
+		{"123e4567-e89b-92d3-a456-426614174000", false, parseErrType},
+		{"123e4567-e89b-42d3-c456-426614174000", false, parseErrType},
+	}
+
+	for n, c := range cases {
+		err := u.Set(c.string)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if n < 5 && u.UUID != v4 {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, v4, u.UUID,
+			)
+		}
+	}
+}
+
+func TestUUID_UnmarshalText(t *testing.T) {
+	var u null.UUID
+	nilType := reflect.TypeOf(nil)
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		bytes   []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(v4String), true, nilType},
+		{nil, false, nilType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := u.UnmarshalText(c.bytes)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+	}
+}
+
+func TestUUID_UnmarshalJSON(t *testing.T) {
+	var u null.UUID
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	unmarshalErrType := reflect.TypeOf(null.UnmarshalError{})
+
+	cases := []struct {
+		json    []byte
+		valid   bool
+		errType reflect.Type
+	}{
+		{[]byte(`"` + v4String + `"`), true, nilType},
+		{[]byte("null"), false, nilType},
+		{[]byte(`""`), false, parseErrType},
+		{[]byte(`"x"`), false, parseErrType},
+		{[]byte("1"), false, typeErrType},
+		{nil, false, unmarshalErrType},
+		{[]byte("x"), false, unmarshalErrType},
+	}
+
+	for n, c := range cases {
+		err := u.UnmarshalJSON(c.json)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+	}
+}
+
+func TestUUID_Scan(t *testing.T) {
+	var u null.UUID
+	nilType := reflect.TypeOf(nil)
+	parseErrType := reflect.TypeOf(null.ParseError{})
+	typeErrType := reflect.TypeOf(null.TypeError{})
+	bad := v4
+	bad[6] = 0
+
+	cases := []struct {
+		source  interface{}
+		valid   bool
+		errType reflect.Type
+	}{
+		{v4[:], true, nilType},
+		{v4String, true, nilType},
+		{[]byte(v4String), true, nilType},
+		{nil, false, nilType},
+		{bad[:], false, parseErrType},
+		{"", false, parseErrType},
+		{[]byte("x"), false, parseErrType},
+		{int64(1), false, typeErrType},
+	}
+
+	for n, c := range cases {
+		err := u.Scan(c.source)
+		if c.errType != reflect.TypeOf(err) {
+			t.Fatalf(
+				"%s, case #%d: wrong error type (expected %v, got %v)",
+				t.Name(), n+1, c.errType, reflect.TypeOf(err),
+			)
+		}
+		if c.valid != u.Valid {
+			t.Fatalf(
+				"%s, case #%d: validity mismatch (expected %t, got %t)",
+				t.Name(), n+1, c.valid, u.Valid,
+			)
+		}
+		if u.Valid && u.UUID != v4 {
+			t.Fatalf(
+				"%s, case #%d: value mismatch (expected %v, got %v)",
+				t.Name(), n+1, v4, u.UUID,
+			)
+		}
+	}
+}